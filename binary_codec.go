@@ -0,0 +1,41 @@
+package sets
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+// errInvalidBinaryData is returned when a binary-encoded set cannot be
+// decoded, e.g. because the input was truncated or corrupted.
+var errInvalidBinaryData = errors.New("sets: invalid binary data")
+
+// encodeUvarints packs a slice of uint64s into a compact length-prefixed
+// varint stream: a varint element count, followed by each element as a
+// varint. This is far smaller than gob or JSON for numeric sets.
+func encodeUvarints(items []uint64) []byte {
+	buf := make([]byte, 0, binary.MaxVarintLen64*(len(items)+1))
+	buf = binary.AppendUvarint(buf, uint64(len(items)))
+	for _, item := range items {
+		buf = binary.AppendUvarint(buf, item)
+	}
+	return buf
+}
+
+// decodeUvarints unpacks a stream produced by encodeUvarints.
+func decodeUvarints(data []byte) ([]uint64, error) {
+	n, size := binary.Uvarint(data)
+	if size <= 0 {
+		return nil, errInvalidBinaryData
+	}
+	data = data[size:]
+	items := make([]uint64, 0, n)
+	for i := uint64(0); i < n; i++ {
+		v, size := binary.Uvarint(data)
+		if size <= 0 {
+			return nil, errInvalidBinaryData
+		}
+		items = append(items, v)
+		data = data[size:]
+	}
+	return items, nil
+}
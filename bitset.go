@@ -0,0 +1,452 @@
+package sets
+
+import "math/bits"
+
+// Uint8Bitset is a set of uint8s, implemented as a fixed-size bit array
+// backed by 4 uint64 words (256 bits). It implements the same surface as
+// Uint8 but trades the map[uint8]struct{} representation for O(1) memory
+// and word-level set operations, which matters for dense domains such as
+// CPU/NUMA masks, port ranges, or character classes.
+type Uint8Bitset [4]uint64
+
+// NewUint8Bitset creates a Uint8Bitset from a list of values.
+func NewUint8Bitset(items ...uint8) Uint8Bitset {
+	var s Uint8Bitset
+	s.Insert(items...)
+	return s
+}
+
+// Insert adds items to the set.
+func (s *Uint8Bitset) Insert(items ...uint8) *Uint8Bitset {
+	for _, item := range items {
+		s[item>>6] |= 1 << (item & 63)
+	}
+	return s
+}
+
+// Delete removes all items from the set.
+func (s *Uint8Bitset) Delete(items ...uint8) *Uint8Bitset {
+	for _, item := range items {
+		s[item>>6] &^= 1 << (item & 63)
+	}
+	return s
+}
+
+// Contains returns true if and only if item is contained in the set.
+func (s Uint8Bitset) Contains(item uint8) bool {
+	return s[item>>6]&(1<<(item&63)) != 0
+}
+
+// Union returns a new set which includes items in either s1 or s2.
+func (s Uint8Bitset) Union(s2 Uint8Bitset) Uint8Bitset {
+	var result Uint8Bitset
+	for i := range s {
+		result[i] = s[i] | s2[i]
+	}
+	return result
+}
+
+// Intersection returns a new set which includes the item in BOTH s1 and s2.
+func (s Uint8Bitset) Intersection(s2 Uint8Bitset) Uint8Bitset {
+	var result Uint8Bitset
+	for i := range s {
+		result[i] = s[i] & s2[i]
+	}
+	return result
+}
+
+// Difference returns a set of objects that are not in s2.
+func (s Uint8Bitset) Difference(s2 Uint8Bitset) Uint8Bitset {
+	var result Uint8Bitset
+	for i := range s {
+		result[i] = s[i] &^ s2[i]
+	}
+	return result
+}
+
+// Equal returns true if and only if s1 is equal (as a set) to s2.
+func (s Uint8Bitset) Equal(s2 Uint8Bitset) bool {
+	return s == s2
+}
+
+// Len returns the size of the set.
+func (s Uint8Bitset) Len() int {
+	n := 0
+	for _, word := range s {
+		n += bits.OnesCount64(word)
+	}
+	return n
+}
+
+// List returns the contents as a sorted uint8 slice.
+func (s Uint8Bitset) List() []uint8 {
+	res := make([]uint8, 0, s.Len())
+	s.Each(func(item uint8) bool {
+		res = append(res, item)
+		return true
+	})
+	return res
+}
+
+// Each traverses the items in the set in ascending order, calling the
+// provided function for each member. Traversal will continue until all
+// items have been visited, or if the closure returns false.
+func (s Uint8Bitset) Each(f func(item uint8) bool) {
+	for i, word := range s {
+		for word != 0 {
+			b := bits.TrailingZeros64(word)
+			if !f(uint8(i<<6 + b)) {
+				return
+			}
+			word &= word - 1
+		}
+	}
+}
+
+// Clone returns a new set with a copy of s.
+func (s Uint8Bitset) Clone() Uint8Bitset {
+	return s
+}
+
+// Int8Bitset is a set of int8s, implemented as a fixed-size bit array
+// backed by 4 uint64 words (256 bits), biased so that int8's range
+// [-128, 127] maps onto bit indices [0, 255].
+type Int8Bitset [4]uint64
+
+// NewInt8Bitset creates an Int8Bitset from a list of values.
+func NewInt8Bitset(items ...int8) Int8Bitset {
+	var s Int8Bitset
+	s.Insert(items...)
+	return s
+}
+
+func int8BitIndex(item int8) uint8 {
+	return uint8(item) ^ 0x80
+}
+
+// Insert adds items to the set.
+func (s *Int8Bitset) Insert(items ...int8) *Int8Bitset {
+	for _, item := range items {
+		idx := int8BitIndex(item)
+		s[idx>>6] |= 1 << (idx & 63)
+	}
+	return s
+}
+
+// Delete removes all items from the set.
+func (s *Int8Bitset) Delete(items ...int8) *Int8Bitset {
+	for _, item := range items {
+		idx := int8BitIndex(item)
+		s[idx>>6] &^= 1 << (idx & 63)
+	}
+	return s
+}
+
+// Contains returns true if and only if item is contained in the set.
+func (s Int8Bitset) Contains(item int8) bool {
+	idx := int8BitIndex(item)
+	return s[idx>>6]&(1<<(idx&63)) != 0
+}
+
+// Union returns a new set which includes items in either s1 or s2.
+func (s Int8Bitset) Union(s2 Int8Bitset) Int8Bitset {
+	var result Int8Bitset
+	for i := range s {
+		result[i] = s[i] | s2[i]
+	}
+	return result
+}
+
+// Intersection returns a new set which includes the item in BOTH s1 and s2.
+func (s Int8Bitset) Intersection(s2 Int8Bitset) Int8Bitset {
+	var result Int8Bitset
+	for i := range s {
+		result[i] = s[i] & s2[i]
+	}
+	return result
+}
+
+// Difference returns a set of objects that are not in s2.
+func (s Int8Bitset) Difference(s2 Int8Bitset) Int8Bitset {
+	var result Int8Bitset
+	for i := range s {
+		result[i] = s[i] &^ s2[i]
+	}
+	return result
+}
+
+// Equal returns true if and only if s1 is equal (as a set) to s2.
+func (s Int8Bitset) Equal(s2 Int8Bitset) bool {
+	return s == s2
+}
+
+// Len returns the size of the set.
+func (s Int8Bitset) Len() int {
+	n := 0
+	for _, word := range s {
+		n += bits.OnesCount64(word)
+	}
+	return n
+}
+
+// List returns the contents as a sorted int8 slice.
+func (s Int8Bitset) List() []int8 {
+	res := make([]int8, 0, s.Len())
+	s.Each(func(item int8) bool {
+		res = append(res, item)
+		return true
+	})
+	return res
+}
+
+// Each traverses the items in the set in ascending order, calling the
+// provided function for each member. Traversal will continue until all
+// items have been visited, or if the closure returns false.
+func (s Int8Bitset) Each(f func(item int8) bool) {
+	for i, word := range s {
+		for word != 0 {
+			b := bits.TrailingZeros64(word)
+			idx := uint8(i<<6 + b)
+			if !f(int8(idx ^ 0x80)) {
+				return
+			}
+			word &= word - 1
+		}
+	}
+}
+
+// Clone returns a new set with a copy of s.
+func (s Int8Bitset) Clone() Int8Bitset {
+	return s
+}
+
+// Int16Bitset is a set of int16s, implemented as a fixed-size bit array
+// backed by 1024 uint64 words (65536 bits), biased so that int16's range
+// [-32768, 32767] maps onto bit indices [0, 65535].
+type Int16Bitset [1024]uint64
+
+// NewInt16Bitset creates an Int16Bitset from a list of values.
+func NewInt16Bitset(items ...int16) *Int16Bitset {
+	s := &Int16Bitset{}
+	s.Insert(items...)
+	return s
+}
+
+func int16BitIndex(item int16) uint16 {
+	return uint16(item) ^ 0x8000
+}
+
+// Insert adds items to the set.
+func (s *Int16Bitset) Insert(items ...int16) *Int16Bitset {
+	for _, item := range items {
+		idx := int16BitIndex(item)
+		s[idx>>6] |= 1 << (idx & 63)
+	}
+	return s
+}
+
+// Delete removes all items from the set.
+func (s *Int16Bitset) Delete(items ...int16) *Int16Bitset {
+	for _, item := range items {
+		idx := int16BitIndex(item)
+		s[idx>>6] &^= 1 << (idx & 63)
+	}
+	return s
+}
+
+// Contains returns true if and only if item is contained in the set.
+func (s *Int16Bitset) Contains(item int16) bool {
+	idx := int16BitIndex(item)
+	return s[idx>>6]&(1<<(idx&63)) != 0
+}
+
+// Union returns a new set which includes items in either s1 or s2.
+func (s *Int16Bitset) Union(s2 *Int16Bitset) *Int16Bitset {
+	result := &Int16Bitset{}
+	for i := range s {
+		result[i] = s[i] | s2[i]
+	}
+	return result
+}
+
+// Intersection returns a new set which includes the item in BOTH s1 and s2.
+func (s *Int16Bitset) Intersection(s2 *Int16Bitset) *Int16Bitset {
+	result := &Int16Bitset{}
+	for i := range s {
+		result[i] = s[i] & s2[i]
+	}
+	return result
+}
+
+// Difference returns a set of objects that are not in s2.
+func (s *Int16Bitset) Difference(s2 *Int16Bitset) *Int16Bitset {
+	result := &Int16Bitset{}
+	for i := range s {
+		result[i] = s[i] &^ s2[i]
+	}
+	return result
+}
+
+// Equal returns true if and only if s1 is equal (as a set) to s2.
+func (s *Int16Bitset) Equal(s2 *Int16Bitset) bool {
+	return *s == *s2
+}
+
+// Len returns the size of the set.
+func (s *Int16Bitset) Len() int {
+	n := 0
+	for _, word := range s {
+		n += bits.OnesCount64(word)
+	}
+	return n
+}
+
+// List returns the contents as a sorted int16 slice.
+func (s *Int16Bitset) List() []int16 {
+	res := make([]int16, 0, s.Len())
+	s.Each(func(item int16) bool {
+		res = append(res, item)
+		return true
+	})
+	return res
+}
+
+// Each traverses the items in the set in ascending order, calling the
+// provided function for each member. Traversal will continue until all
+// items have been visited, or if the closure returns false.
+func (s *Int16Bitset) Each(f func(item int16) bool) {
+	for i, word := range s {
+		for word != 0 {
+			b := bits.TrailingZeros64(word)
+			idx := uint16(i<<6 + b)
+			if !f(int16(idx ^ 0x8000)) {
+				return
+			}
+			word &= word - 1
+		}
+	}
+}
+
+// Clone returns a new set with a copy of s.
+func (s *Int16Bitset) Clone() *Int16Bitset {
+	clone := *s
+	return &clone
+}
+
+// BitByte is a set of bytes, implemented as a fixed-size bit array backed
+// by 4 uint64 words (256 bits), like Uint8Bitset. It exists as a drop-in,
+// same-surface alternative to the map[byte]struct{}-backed Byte for
+// callers that want a 32-byte footprint and O(1) word-level operations
+// instead of map overhead.
+type BitByte [4]uint64
+
+// NewBitByte creates a BitByte from a list of values.
+func NewBitByte(items ...byte) BitByte {
+	var s BitByte
+	s.Insert(items...)
+	return s
+}
+
+// NewByteFromBitmap creates a BitByte directly from its 4-word bitmap
+// representation, as produced by Bitmap.
+func NewByteFromBitmap(bitmap [4]uint64) BitByte {
+	return BitByte(bitmap)
+}
+
+// Bitmap returns the set's 4-word bitmap representation, for compact
+// (32-byte) storage or transmission; round-trip it through
+// NewByteFromBitmap.
+func (s BitByte) Bitmap() [4]uint64 {
+	return s
+}
+
+// BitByte is byte-for-byte the same representation as Uint8Bitset (byte is
+// itself just an alias for uint8), so every method it shares with Uint8Bitset
+// delegates there via a type conversion rather than re-deriving the same
+// bit-twiddling.
+
+// Insert adds items to the set.
+func (s *BitByte) Insert(items ...byte) *BitByte {
+	(*Uint8Bitset)(s).Insert(items...)
+	return s
+}
+
+// Delete removes all items from the set.
+func (s *BitByte) Delete(items ...byte) *BitByte {
+	(*Uint8Bitset)(s).Delete(items...)
+	return s
+}
+
+// Contains returns true if and only if item is contained in the set.
+func (s BitByte) Contains(item byte) bool {
+	return Uint8Bitset(s).Contains(item)
+}
+
+// Union returns a new set which includes items in either s or s2.
+func (s BitByte) Union(s2 BitByte) BitByte {
+	return BitByte(Uint8Bitset(s).Union(Uint8Bitset(s2)))
+}
+
+// Intersection returns a new set which includes the items in BOTH s and s2.
+func (s BitByte) Intersection(s2 BitByte) BitByte {
+	return BitByte(Uint8Bitset(s).Intersection(Uint8Bitset(s2)))
+}
+
+// Difference returns a set of objects that are in s but not in s2.
+func (s BitByte) Difference(s2 BitByte) BitByte {
+	return BitByte(Uint8Bitset(s).Difference(Uint8Bitset(s2)))
+}
+
+// SymmetricDifference returns a set of objects that are in s or s2, but
+// not both. Uint8Bitset has no equivalent to delegate to, so this still
+// does its own word-level XOR.
+func (s BitByte) SymmetricDifference(s2 BitByte) BitByte {
+	var result BitByte
+	for i := range s {
+		result[i] = s[i] ^ s2[i]
+	}
+	return result
+}
+
+// Equal returns true if and only if s is equal (as a set) to s2.
+func (s BitByte) Equal(s2 BitByte) bool {
+	return Uint8Bitset(s).Equal(Uint8Bitset(s2))
+}
+
+// Len returns the size of the set.
+func (s BitByte) Len() int {
+	return Uint8Bitset(s).Len()
+}
+
+// List returns the contents as a sorted byte slice.
+func (s BitByte) List() []byte {
+	return Uint8Bitset(s).List()
+}
+
+// Each traverses the items in the set in ascending order, calling the
+// provided function for each member. Traversal will continue until all
+// items have been visited, or if the closure returns false.
+func (s BitByte) Each(f func(item byte) bool) {
+	Uint8Bitset(s).Each(f)
+}
+
+// Pop removes and returns an arbitrary element of the set (the lowest-
+// numbered one, since that's what falls out of scanning words in order).
+// Uint8Bitset has no equivalent to delegate to, so this still scans words
+// directly.
+func (s *BitByte) Pop() (v byte, ok bool) {
+	for i, word := range s {
+		if word != 0 {
+			b := bits.TrailingZeros64(word)
+			v = byte(i<<6 + b)
+			s[i] &^= 1 << (v & 63)
+			return v, true
+		}
+	}
+	return 0, false
+}
+
+// Clone returns a new set with a copy of s.
+func (s BitByte) Clone() BitByte {
+	return s
+}
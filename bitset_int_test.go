@@ -0,0 +1,63 @@
+package sets
+
+import "testing"
+
+func TestBitSet_InsertContainsDelete(t *testing.T) {
+	s := NewBitSet(0)
+	s.Insert(1, 130, 64)
+	if s.Len() != 3 {
+		t.Errorf("Expected len=3: %d", s.Len())
+	}
+	if !s.Contains(130) {
+		t.Errorf("Missing contents: %v", s.List())
+	}
+	s.Delete(130)
+	if s.Contains(130) {
+		t.Errorf("Unexpected contents: %v", s.List())
+	}
+	if !s.Contains(1) || !s.Contains(64) {
+		t.Errorf("Missing contents: %v", s.List())
+	}
+}
+
+func TestBitSet_SetOps(t *testing.T) {
+	a := NewBitSet(0).Insert(1, 2, 3, 200)
+	b := NewBitSet(0).Insert(2, 3, 4)
+
+	if !a.Union(b).Equal(NewBitSet(0).Insert(1, 2, 3, 4, 200)) {
+		t.Errorf("Unexpected union: %v", a.Union(b).List())
+	}
+	if !a.Intersection(b).Equal(NewBitSet(0).Insert(2, 3)) {
+		t.Errorf("Unexpected intersection: %v", a.Intersection(b).List())
+	}
+	if !a.Difference(b).Equal(NewBitSet(0).Insert(1, 200)) {
+		t.Errorf("Unexpected difference: %v", a.Difference(b).List())
+	}
+	if !NewBitSet(0).Insert(2, 3).IsSubset(a) {
+		t.Errorf("Expected subset")
+	}
+	if !a.IsSuperset(NewBitSet(0).Insert(2, 3)) {
+		t.Errorf("Expected superset")
+	}
+}
+
+func TestBitSet_IntBridge(t *testing.T) {
+	i := NewInt(1, 2, 3)
+	b := FromInt(i)
+	if b.Len() != 3 {
+		t.Errorf("Expected len=3: %d", b.Len())
+	}
+	back := b.ToInt()
+	if !back.Equal(i) {
+		t.Errorf("Expected %v, got %v", i.List(), back.List())
+	}
+}
+
+func TestBitSet_Clone(t *testing.T) {
+	a := NewBitSet(0).Insert(1, 2, 3)
+	b := a.Clone()
+	b.Insert(4)
+	if a.Contains(4) {
+		t.Errorf("Clone should not share storage with original")
+	}
+}
@@ -0,0 +1,352 @@
+package sets
+
+import "sort"
+
+// roaring is a Roaring-style compressed bitmap: the key space is split into
+// 16-bit chunks, and each chunk is stored as a container that is either a
+// sorted array (sparse chunks) or a bitmap (dense chunks). Compared to the
+// map[T]struct{} sets elsewhere in this package, it trades per-element
+// overhead for memory density on large, clustered integer domains.
+type roaring struct {
+	chunks map[uint64]*container
+}
+
+func newRoaring() roaring {
+	return roaring{chunks: make(map[uint64]*container)}
+}
+
+func (r *roaring) insert(chunk uint64, pos uint16) bool {
+	c, ok := r.chunks[chunk]
+	if !ok {
+		c = newArrayContainer()
+		r.chunks[chunk] = c
+	}
+	return c.insert(pos)
+}
+
+func (r *roaring) remove(chunk uint64, pos uint16) bool {
+	c, ok := r.chunks[chunk]
+	if !ok {
+		return false
+	}
+	removed := c.remove(pos)
+	if removed && c.len() == 0 {
+		delete(r.chunks, chunk)
+	}
+	return removed
+}
+
+func (r *roaring) contains(chunk uint64, pos uint16) bool {
+	c, ok := r.chunks[chunk]
+	return ok && c.contains(pos)
+}
+
+func (r *roaring) len() int {
+	n := 0
+	for _, c := range r.chunks {
+		n += c.len()
+	}
+	return n
+}
+
+// sortedChunkKeys returns the keys of r.chunks in ascending order.
+func (r *roaring) sortedChunkKeys() []uint64 {
+	keys := make([]uint64, 0, len(r.chunks))
+	for k := range r.chunks {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	return keys
+}
+
+func (r *roaring) clone() roaring {
+	nr := newRoaring()
+	for k, c := range r.chunks {
+		nr.chunks[k] = c.clone()
+	}
+	return nr
+}
+
+// combine merges r and other chunk-by-chunk using op, skipping chunks that
+// op reduces to empty.
+func (r *roaring) combine(other roaring, op func(a, b *container) *container) roaring {
+	result := newRoaring()
+	empty := newArrayContainer()
+	keys := make(map[uint64]struct{}, len(r.chunks)+len(other.chunks))
+	for k := range r.chunks {
+		keys[k] = struct{}{}
+	}
+	for k := range other.chunks {
+		keys[k] = struct{}{}
+	}
+	for k := range keys {
+		a, ok := r.chunks[k]
+		if !ok {
+			a = empty
+		}
+		b, ok := other.chunks[k]
+		if !ok {
+			b = empty
+		}
+		c := op(a, b)
+		if c.len() > 0 {
+			result.chunks[k] = c
+		}
+	}
+	return result
+}
+
+func int64ToOrdered(v int64) uint64 {
+	return uint64(v) ^ (1 << 63)
+}
+
+func orderedToInt64(u uint64) int64 {
+	return int64(u ^ (1 << 63))
+}
+
+// BitUint16 is a set of uint16s backed by a single Roaring-style container,
+// sized for cases where the whole uint16 domain may be densely populated.
+type BitUint16 struct {
+	c *container
+}
+
+// NewBitUint16 creates a BitUint16 from a list of values.
+func NewBitUint16(items ...uint16) *BitUint16 {
+	s := &BitUint16{c: newArrayContainer()}
+	s.Insert(items...)
+	return s
+}
+
+// Insert adds items to the set.
+func (s *BitUint16) Insert(items ...uint16) *BitUint16 {
+	for _, item := range items {
+		s.c.insert(item)
+	}
+	return s
+}
+
+// Delete removes items from the set.
+func (s *BitUint16) Delete(items ...uint16) *BitUint16 {
+	for _, item := range items {
+		s.c.remove(item)
+	}
+	return s
+}
+
+// Contains returns true if and only if item is contained in the set.
+func (s *BitUint16) Contains(item uint16) bool {
+	return s.c.contains(item)
+}
+
+// Cardinality returns the number of elements in the set.
+func (s *BitUint16) Cardinality() int {
+	return s.c.len()
+}
+
+// List returns the contents as a sorted uint16 slice.
+func (s *BitUint16) List() []uint16 {
+	return append([]uint16(nil), s.c.values()...)
+}
+
+// Clone returns a new BitUint16 with a copy of s.
+func (s *BitUint16) Clone() *BitUint16 {
+	return &BitUint16{c: s.c.clone()}
+}
+
+// Union returns a new set which includes items in either s or s2.
+func (s *BitUint16) Union(s2 *BitUint16) *BitUint16 {
+	return &BitUint16{c: s.c.union(s2.c)}
+}
+
+// Intersection returns a new set which includes items in both s and s2.
+func (s *BitUint16) Intersection(s2 *BitUint16) *BitUint16 {
+	return &BitUint16{c: s.c.intersect(s2.c)}
+}
+
+// Difference returns a set of items in s that are not in s2.
+func (s *BitUint16) Difference(s2 *BitUint16) *BitUint16 {
+	return &BitUint16{c: s.c.diff(s2.c)}
+}
+
+// Iterator yields the set's elements in ascending order.
+func (s *BitUint16) Iterator() Iterator[uint16] {
+	return &sliceIterator[uint16]{items: s.List()}
+}
+
+// BitUint32 is a set of uint32s backed by a Roaring-style compressed
+// bitmap: values are split into a 16-bit chunk key (the high bits) and a
+// 16-bit position within that chunk's container.
+type BitUint32 struct {
+	r roaring
+}
+
+// NewBitUint32 creates a BitUint32 from a list of values.
+func NewBitUint32(items ...uint32) *BitUint32 {
+	s := &BitUint32{r: newRoaring()}
+	s.Insert(items...)
+	return s
+}
+
+func splitUint32(v uint32) (chunk uint64, pos uint16) {
+	return uint64(v >> 16), uint16(v)
+}
+
+func joinUint32(chunk uint64, pos uint16) uint32 {
+	return uint32(chunk)<<16 | uint32(pos)
+}
+
+// Insert adds items to the set.
+func (s *BitUint32) Insert(items ...uint32) *BitUint32 {
+	for _, item := range items {
+		chunk, pos := splitUint32(item)
+		s.r.insert(chunk, pos)
+	}
+	return s
+}
+
+// Delete removes items from the set.
+func (s *BitUint32) Delete(items ...uint32) *BitUint32 {
+	for _, item := range items {
+		chunk, pos := splitUint32(item)
+		s.r.remove(chunk, pos)
+	}
+	return s
+}
+
+// Contains returns true if and only if item is contained in the set.
+func (s *BitUint32) Contains(item uint32) bool {
+	chunk, pos := splitUint32(item)
+	return s.r.contains(chunk, pos)
+}
+
+// Cardinality returns the number of elements in the set.
+func (s *BitUint32) Cardinality() int {
+	return s.r.len()
+}
+
+// List returns the contents as a sorted uint32 slice.
+func (s *BitUint32) List() []uint32 {
+	res := make([]uint32, 0, s.r.len())
+	for _, chunk := range s.r.sortedChunkKeys() {
+		for _, pos := range s.r.chunks[chunk].values() {
+			res = append(res, joinUint32(chunk, pos))
+		}
+	}
+	return res
+}
+
+// Clone returns a new BitUint32 with a copy of s.
+func (s *BitUint32) Clone() *BitUint32 {
+	return &BitUint32{r: s.r.clone()}
+}
+
+// Union returns a new set which includes items in either s or s2.
+func (s *BitUint32) Union(s2 *BitUint32) *BitUint32 {
+	return &BitUint32{r: s.r.combine(s2.r, (*container).union)}
+}
+
+// Intersection returns a new set which includes items in both s and s2.
+func (s *BitUint32) Intersection(s2 *BitUint32) *BitUint32 {
+	return &BitUint32{r: s.r.combine(s2.r, (*container).intersect)}
+}
+
+// Difference returns a set of items in s that are not in s2.
+func (s *BitUint32) Difference(s2 *BitUint32) *BitUint32 {
+	return &BitUint32{r: s.r.combine(s2.r, (*container).diff)}
+}
+
+// Iterator yields the set's elements in ascending order. It iterates over a
+// snapshot taken with List, so the whole set is materialized up front.
+func (s *BitUint32) Iterator() Iterator[uint32] {
+	return &sliceIterator[uint32]{items: s.List()}
+}
+
+// BitInt64 is a set of int64s backed by a Roaring-style compressed bitmap.
+// Values are bias-shifted so that their unsigned bit pattern sorts in the
+// same order as the signed value, then split into a chunk key (all but the
+// low 16 bits) and a position within that chunk's container.
+type BitInt64 struct {
+	r roaring
+}
+
+// NewBitInt64 creates a BitInt64 from a list of values.
+func NewBitInt64(items ...int64) *BitInt64 {
+	s := &BitInt64{r: newRoaring()}
+	s.Insert(items...)
+	return s
+}
+
+func splitInt64(v int64) (chunk uint64, pos uint16) {
+	ordered := int64ToOrdered(v)
+	return ordered >> 16, uint16(ordered)
+}
+
+func joinInt64(chunk uint64, pos uint16) int64 {
+	return orderedToInt64(chunk<<16 | uint64(pos))
+}
+
+// Insert adds items to the set.
+func (s *BitInt64) Insert(items ...int64) *BitInt64 {
+	for _, item := range items {
+		chunk, pos := splitInt64(item)
+		s.r.insert(chunk, pos)
+	}
+	return s
+}
+
+// Delete removes items from the set.
+func (s *BitInt64) Delete(items ...int64) *BitInt64 {
+	for _, item := range items {
+		chunk, pos := splitInt64(item)
+		s.r.remove(chunk, pos)
+	}
+	return s
+}
+
+// Contains returns true if and only if item is contained in the set.
+func (s *BitInt64) Contains(item int64) bool {
+	chunk, pos := splitInt64(item)
+	return s.r.contains(chunk, pos)
+}
+
+// Cardinality returns the number of elements in the set.
+func (s *BitInt64) Cardinality() int {
+	return s.r.len()
+}
+
+// List returns the contents as a sorted int64 slice.
+func (s *BitInt64) List() []int64 {
+	res := make([]int64, 0, s.r.len())
+	for _, chunk := range s.r.sortedChunkKeys() {
+		for _, pos := range s.r.chunks[chunk].values() {
+			res = append(res, joinInt64(chunk, pos))
+		}
+	}
+	return res
+}
+
+// Clone returns a new BitInt64 with a copy of s.
+func (s *BitInt64) Clone() *BitInt64 {
+	return &BitInt64{r: s.r.clone()}
+}
+
+// Union returns a new set which includes items in either s or s2.
+func (s *BitInt64) Union(s2 *BitInt64) *BitInt64 {
+	return &BitInt64{r: s.r.combine(s2.r, (*container).union)}
+}
+
+// Intersection returns a new set which includes items in both s and s2.
+func (s *BitInt64) Intersection(s2 *BitInt64) *BitInt64 {
+	return &BitInt64{r: s.r.combine(s2.r, (*container).intersect)}
+}
+
+// Difference returns a set of items in s that are not in s2.
+func (s *BitInt64) Difference(s2 *BitInt64) *BitInt64 {
+	return &BitInt64{r: s.r.combine(s2.r, (*container).diff)}
+}
+
+// Iterator yields the set's elements in ascending order. It iterates over a
+// snapshot taken with List, so the whole set is materialized up front.
+func (s *BitInt64) Iterator() Iterator[int64] {
+	return &sliceIterator[int64]{items: s.List()}
+}
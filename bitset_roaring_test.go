@@ -0,0 +1,127 @@
+package sets
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBitUint16_InsertContainsDelete(t *testing.T) {
+	s := NewBitUint16(1, 65535, 32768)
+	if s.Cardinality() != 3 {
+		t.Errorf("Expected cardinality=3: %d", s.Cardinality())
+	}
+	if !s.Contains(65535) {
+		t.Errorf("Missing contents: %v", s.List())
+	}
+	s.Delete(65535)
+	if s.Contains(65535) {
+		t.Errorf("Unexpected contents: %v", s.List())
+	}
+	if got, want := s.List(), []uint16{1, 32768}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+}
+
+func TestBitUint16_SetOps(t *testing.T) {
+	a := NewBitUint16(1, 2, 3)
+	b := NewBitUint16(2, 3, 4)
+	if got, want := a.Union(b).List(), []uint16{1, 2, 3, 4}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Unexpected union: %v", got)
+	}
+	if got, want := a.Intersection(b).List(), []uint16{2, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Unexpected intersection: %v", got)
+	}
+	if got, want := a.Difference(b).List(), []uint16{1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Unexpected difference: %v", got)
+	}
+	if got, want := a.Clone().List(), a.List(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Clone should equal original: %v vs %v", got, want)
+	}
+}
+
+func TestBitUint16_ArrayBitmapThreshold(t *testing.T) {
+	s := NewBitUint16()
+	for i := 0; i <= containerThreshold; i++ {
+		s.Insert(uint16(i))
+	}
+	if !s.c.isBitmap() {
+		t.Fatalf("Expected container to convert to bitmap past threshold")
+	}
+	if s.Cardinality() != containerThreshold+1 {
+		t.Errorf("Expected cardinality=%d: %d", containerThreshold+1, s.Cardinality())
+	}
+	if !s.Contains(0) || !s.Contains(containerThreshold) {
+		t.Errorf("Missing contents after conversion to bitmap")
+	}
+}
+
+func TestBitUint32_InsertContainsDelete(t *testing.T) {
+	s := NewBitUint32(1, 1<<20, 1<<31)
+	if s.Cardinality() != 3 {
+		t.Errorf("Expected cardinality=3: %d", s.Cardinality())
+	}
+	if !s.Contains(1 << 31) {
+		t.Errorf("Missing contents: %v", s.List())
+	}
+	s.Delete(1 << 31)
+	if s.Contains(1 << 31) {
+		t.Errorf("Unexpected contents: %v", s.List())
+	}
+}
+
+func TestBitUint32_SetOps(t *testing.T) {
+	a := NewBitUint32(1, 1<<16, 1<<20)
+	b := NewBitUint32(1<<16, 1<<20, 1<<24)
+	if got, want := a.Union(b).List(), []uint32{1, 1 << 16, 1 << 20, 1 << 24}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Unexpected union: %v", got)
+	}
+	if got, want := a.Intersection(b).List(), []uint32{1 << 16, 1 << 20}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Unexpected intersection: %v", got)
+	}
+	if got, want := a.Difference(b).List(), []uint32{1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Unexpected difference: %v", got)
+	}
+}
+
+func TestBitInt64_InsertContainsDelete(t *testing.T) {
+	s := NewBitInt64(-1, 0, 1, -1<<62)
+	if s.Cardinality() != 4 {
+		t.Errorf("Expected cardinality=4: %d", s.Cardinality())
+	}
+	if !s.Contains(-1 << 62) {
+		t.Errorf("Missing contents: %v", s.List())
+	}
+	if got, want := s.List(), []int64{-1 << 62, -1, 0, 1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected ascending order %v, got %v", want, got)
+	}
+}
+
+func TestBitInt64_SetOps(t *testing.T) {
+	a := NewBitInt64(-10, 0, 10)
+	b := NewBitInt64(0, 10, 20)
+	if got, want := a.Union(b).List(), []int64{-10, 0, 10, 20}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Unexpected union: %v", got)
+	}
+	if got, want := a.Intersection(b).List(), []int64{0, 10}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Unexpected intersection: %v", got)
+	}
+	if got, want := a.Difference(b).List(), []int64{-10}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Unexpected difference: %v", got)
+	}
+}
+
+func TestBitUint32_Iterator(t *testing.T) {
+	s := NewBitUint32(3, 1, 2)
+	it := s.Iterator()
+	var got []uint32
+	for {
+		v, ok := it.Next()
+		if !ok {
+			break
+		}
+		got = append(got, v)
+	}
+	if want := []uint32{1, 2, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+}
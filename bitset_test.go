@@ -0,0 +1,162 @@
+package sets
+
+import "testing"
+
+func TestUint8Bitset(t *testing.T) {
+	s := NewUint8Bitset(1, 2, 3, 255)
+	if s.Len() != 4 {
+		t.Errorf("Expected len=4: %d", s.Len())
+	}
+	if !s.Contains(255) {
+		t.Errorf("Missing contents: %#v", s)
+	}
+	s.Delete(255)
+	if s.Contains(255) {
+		t.Errorf("Unexpected contents: %#v", s)
+	}
+	if got, want := s.List(), []uint8{1, 2, 3}; !equalUint8Slice(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+
+	a := NewUint8Bitset(1, 2, 3)
+	b := NewUint8Bitset(2, 3, 4)
+	if !a.Union(b).Equal(NewUint8Bitset(1, 2, 3, 4)) {
+		t.Errorf("Unexpected union: %#v", a.Union(b))
+	}
+	if !a.Intersection(b).Equal(NewUint8Bitset(2, 3)) {
+		t.Errorf("Unexpected intersection: %#v", a.Intersection(b))
+	}
+	if !a.Difference(b).Equal(NewUint8Bitset(1)) {
+		t.Errorf("Unexpected difference: %#v", a.Difference(b))
+	}
+	if !a.Clone().Equal(a) {
+		t.Errorf("Clone should equal original")
+	}
+}
+
+func TestInt8Bitset(t *testing.T) {
+	s := NewInt8Bitset(-128, -1, 0, 127)
+	if s.Len() != 4 {
+		t.Errorf("Expected len=4: %d", s.Len())
+	}
+	if !s.Contains(-128) || !s.Contains(127) {
+		t.Errorf("Missing contents: %#v", s)
+	}
+	s.Delete(-128)
+	if s.Contains(-128) {
+		t.Errorf("Unexpected contents: %#v", s)
+	}
+	if got, want := s.List(), []int8{-1, 0, 127}; !equalInt8Slice(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+
+	a := NewInt8Bitset(-2, -1, 0)
+	b := NewInt8Bitset(-1, 0, 1)
+	if !a.Union(b).Equal(NewInt8Bitset(-2, -1, 0, 1)) {
+		t.Errorf("Unexpected union: %#v", a.Union(b))
+	}
+	if !a.Intersection(b).Equal(NewInt8Bitset(-1, 0)) {
+		t.Errorf("Unexpected intersection: %#v", a.Intersection(b))
+	}
+}
+
+func TestInt16Bitset(t *testing.T) {
+	s := NewInt16Bitset(-32768, -1, 0, 32767)
+	if s.Len() != 4 {
+		t.Errorf("Expected len=4: %d", s.Len())
+	}
+	if !s.Contains(-32768) || !s.Contains(32767) {
+		t.Errorf("Missing contents: %#v", s)
+	}
+	s.Delete(-32768)
+	if s.Contains(-32768) {
+		t.Errorf("Unexpected contents: %#v", s)
+	}
+
+	a := NewInt16Bitset(-2, -1, 0)
+	b := NewInt16Bitset(-1, 0, 1)
+	if !a.Union(b).Equal(NewInt16Bitset(-2, -1, 0, 1)) {
+		t.Errorf("Unexpected union: %#v", a.Union(b))
+	}
+	if !a.Intersection(b).Equal(NewInt16Bitset(-1, 0)) {
+		t.Errorf("Unexpected intersection: %#v", a.Intersection(b))
+	}
+	if !a.Clone().Equal(a) {
+		t.Errorf("Clone should equal original")
+	}
+}
+
+func TestBitByte(t *testing.T) {
+	s := NewBitByte(1, 2, 3, 255)
+	if s.Len() != 4 {
+		t.Errorf("Expected len=4: %d", s.Len())
+	}
+	if !s.Contains(255) {
+		t.Errorf("Missing contents: %#v", s)
+	}
+	s.Delete(255)
+	if s.Contains(255) {
+		t.Errorf("Unexpected contents: %#v", s)
+	}
+	if got, want := s.List(), []byte{1, 2, 3}; !equalUint8Slice(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+
+	a := NewBitByte(1, 2, 3)
+	b := NewBitByte(2, 3, 4)
+	if !a.Union(b).Equal(NewBitByte(1, 2, 3, 4)) {
+		t.Errorf("Unexpected union: %#v", a.Union(b))
+	}
+	if !a.Intersection(b).Equal(NewBitByte(2, 3)) {
+		t.Errorf("Unexpected intersection: %#v", a.Intersection(b))
+	}
+	if !a.Difference(b).Equal(NewBitByte(1)) {
+		t.Errorf("Unexpected difference: %#v", a.Difference(b))
+	}
+	if !a.SymmetricDifference(b).Equal(NewBitByte(1, 4)) {
+		t.Errorf("Unexpected symmetric difference: %#v", a.SymmetricDifference(b))
+	}
+	if !a.Clone().Equal(a) {
+		t.Errorf("Clone should equal original")
+	}
+
+	v, ok := a.Pop()
+	if !ok || v != 1 {
+		t.Errorf("Expected Pop()=1, got %v, %v", v, ok)
+	}
+	if a.Contains(1) {
+		t.Errorf("Pop should have removed the element: %#v", a)
+	}
+}
+
+func TestBitByte_BitmapRoundTrip(t *testing.T) {
+	s := NewBitByte(1, 64, 200)
+	got := NewByteFromBitmap(s.Bitmap())
+	if !got.Equal(s) {
+		t.Errorf("Expected %#v, got %#v", s, got)
+	}
+}
+
+func equalUint8Slice(a, b []uint8) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func equalInt8Slice(a, b []int8) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -17,8 +17,16 @@ limitations under the License.
 package sets
 
 import (
+	"bytes"
+	"encoding/binary"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"math/bits"
 	"reflect"
 	"sort"
+	"strconv"
+	"strings"
 )
 
 // Byte is a set of bytes, implemented via map[byte]struct{} for minimal memory consumption.
@@ -243,3 +251,161 @@ func (s Byte) Clone() Byte {
 	})
 	return ns
 }
+
+// MarshalJSON implements json.Marshaler, encoding the set as a sorted JSON
+// array of numbers for deterministic output. The elements are widened to
+// uint16 first, since encoding/json special-cases []byte as a base64
+// string rather than an array.
+func (s Byte) MarshalJSON() ([]byte, error) {
+	items := s.List()
+	nums := make([]uint16, len(items))
+	for i, item := range items {
+		nums[i] = uint16(item)
+	}
+	return json.Marshal(nums)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It accepts a JSON array of
+// numbers (the format MarshalJSON produces), a base64 string (the format
+// json.Marshal(s.List()) produces, since encoding/json special-cases
+// []byte, for backwards compatibility with anything already persisted that
+// way), or an object whose keys parse as bytes (values are ignored).
+func (s *Byte) UnmarshalJSON(data []byte) error {
+	var nums []uint16
+	if err := json.Unmarshal(data, &nums); err == nil {
+		if err := checkDuplicates(nums); err != nil {
+			return err
+		}
+		ret := NewByte()
+		for _, n := range nums {
+			ret.Insert(byte(n))
+		}
+		*s = ret
+		return nil
+	}
+	var raw []byte
+	if err := json.Unmarshal(data, &raw); err == nil {
+		*s = NewByte(raw...)
+		return nil
+	}
+	m := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &m); err != nil {
+		return err
+	}
+	ret := NewByte()
+	for k := range m {
+		v, err := strconv.ParseUint(k, 10, 8)
+		if err != nil {
+			return err
+		}
+		ret.Insert(byte(v))
+	}
+	*s = ret
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder.
+func (s Byte) GobEncode() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(s.List()); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// GobDecode implements gob.GobDecoder.
+func (s *Byte) GobDecode(data []byte) error {
+	var items []byte
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&items); err != nil {
+		return err
+	}
+	*s = NewByte(items...)
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, encoding the set as a
+// fixed 32-byte bitmap (one bit per possible byte value) instead of a
+// varint stream, so it round-trips in constant size regardless of Len().
+func (s Byte) MarshalBinary() ([]byte, error) {
+	var words [4]uint64
+	for item := range s {
+		words[item>>6] |= 1 << (item & 63)
+	}
+	buf := make([]byte, 32)
+	for i, w := range words {
+		binary.LittleEndian.PutUint64(buf[i*8:], w)
+	}
+	return buf, nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *Byte) UnmarshalBinary(data []byte) error {
+	if len(data) != 32 {
+		return fmt.Errorf("sets: Byte.UnmarshalBinary: want 32 bytes, got %d", len(data))
+	}
+	ret := NewByte()
+	for i := 0; i < 4; i++ {
+		w := binary.LittleEndian.Uint64(data[i*8:])
+		for w != 0 {
+			bit := bits.TrailingZeros64(w)
+			ret.Insert(byte(i*64 + bit))
+			w &= w - 1
+		}
+	}
+	*s = ret
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding the set as a
+// sorted, comma-separated list for use in URL query params and env vars.
+func (s Byte) MarshalText() ([]byte, error) {
+	items := s.List()
+	parts := make([]string, len(items))
+	for i, item := range items {
+		parts[i] = strconv.FormatUint(uint64(item), 10)
+	}
+	return []byte(strings.Join(parts, ",")), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *Byte) UnmarshalText(text []byte) error {
+	ret := NewByte()
+	str := strings.TrimSpace(string(text))
+	if str == "" {
+		*s = ret
+		return nil
+	}
+	for _, part := range strings.Split(str, ",") {
+		v, err := strconv.ParseUint(strings.TrimSpace(part), 10, 8)
+		if err != nil {
+			return err
+		}
+		ret.Insert(byte(v))
+	}
+	*s = ret
+	return nil
+}
+
+// Fingerprint returns an order-independent hash of the set's contents,
+// suitable for cheaply comparing or caching set states: two Byte sets with
+// identical membership always produce the same fingerprint, regardless of
+// map iteration order.
+func (s Byte) Fingerprint() uint64 {
+	var fp uint64
+	for item := range s {
+		fp ^= mix64(uint64(item))
+	}
+	return fp
+}
+
+// FromByte converts a Byte to the generic Set[byte]. Byte and Set[byte]
+// share the same underlying map[byte]struct{} representation, so this is a
+// plain type conversion, not a copy.
+func FromByte(s Byte) Set[byte] {
+	return Set[byte](s)
+}
+
+// ToByte converts a generic Set[byte] to a Byte, the inverse of FromByte.
+func ToByte(s Set[byte]) Byte {
+	return Byte(s)
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package sets
 
 import (
+	"encoding/json"
 	"reflect"
 	"testing"
 
@@ -356,3 +357,121 @@ func TestByte_Clone(t *testing.T) {
 
 	require.True(t, s1.Equal(s2))
 }
+
+func TestByte_JSONRoundTrip(t *testing.T) {
+	s := NewByte(3, 1, 2)
+	data, err := s.MarshalJSON()
+	require.NoError(t, err)
+	require.Equal(t, "[1,2,3]", string(data))
+
+	var got Byte
+	require.NoError(t, got.UnmarshalJSON(data))
+	require.True(t, got.Equal(s))
+}
+
+func TestByte_GobRoundTrip(t *testing.T) {
+	s := NewByte(3, 1, 2)
+	data, err := s.GobEncode()
+	require.NoError(t, err)
+
+	var got Byte
+	require.NoError(t, got.GobDecode(data))
+	require.True(t, got.Equal(s))
+}
+
+func TestByte_BinaryRoundTrip(t *testing.T) {
+	s := NewByte(3, 1, 2, 0, 255)
+	data, err := s.MarshalBinary()
+	require.NoError(t, err)
+	require.Len(t, data, 32)
+
+	var got Byte
+	require.NoError(t, got.UnmarshalBinary(data))
+	require.True(t, got.Equal(s))
+}
+
+func TestByte_BinaryRoundTripEmpty(t *testing.T) {
+	s := NewByte()
+	data, err := s.MarshalBinary()
+	require.NoError(t, err)
+	require.Len(t, data, 32)
+
+	var got Byte
+	require.NoError(t, got.UnmarshalBinary(data))
+	require.True(t, got.Equal(s))
+}
+
+func TestByte_UnmarshalBinaryRejectsWrongSize(t *testing.T) {
+	var got Byte
+	require.Error(t, got.UnmarshalBinary([]byte{1, 2, 3}))
+}
+
+func TestByte_TextRoundTrip(t *testing.T) {
+	s := NewByte(3, 1, 2)
+	data, err := s.MarshalText()
+	require.NoError(t, err)
+	require.Equal(t, "1,2,3", string(data))
+
+	var got Byte
+	require.NoError(t, got.UnmarshalText(data))
+	require.True(t, got.Equal(s))
+}
+
+func TestByte_TextRoundTripEmpty(t *testing.T) {
+	s := NewByte()
+	data, err := s.MarshalText()
+	require.NoError(t, err)
+
+	var got Byte
+	require.NoError(t, got.UnmarshalText(data))
+	require.True(t, got.Equal(s))
+}
+
+func TestByte_JSONBackwardsCompatibleWithListMarshal(t *testing.T) {
+	s := NewByte(3, 1, 2)
+
+	legacy, err := json.Marshal(s.List())
+	require.NoError(t, err)
+
+	var got Byte
+	require.NoError(t, got.UnmarshalJSON(legacy))
+	require.True(t, got.Equal(s))
+}
+
+func TestByte_Fingerprint(t *testing.T) {
+	a := NewByte(1, 2, 3)
+	b := NewByte(3, 2, 1)
+	require.Equal(t, a.Fingerprint(), b.Fingerprint())
+
+	c := NewByte(1, 2, 4)
+	require.NotEqual(t, a.Fingerprint(), c.Fingerprint())
+}
+
+func TestByte_SymmetricDifference(t *testing.T) {
+	a := NewByte(1, 2, 3)
+	b := NewByte(2, 3, 4)
+	require.True(t, a.SymmetricDifference(b).Equal(NewByte(1, 4)))
+	require.True(t, a.SymmetricDifference(b).Equal(b.SymmetricDifference(a)))
+}
+
+func TestByte_FilterAnyAll(t *testing.T) {
+	s := NewByte(1, 2, 3, 4, 5)
+
+	even := s.Filter(func(item byte) bool { return item%2 == 0 })
+	require.True(t, even.Equal(NewByte(2, 4)))
+
+	require.True(t, s.Any(func(item byte) bool { return item == 3 }))
+	require.False(t, s.Any(func(item byte) bool { return item == 10 }))
+
+	require.True(t, s.All(func(item byte) bool { return item > 0 }))
+	require.False(t, s.All(func(item byte) bool { return item > 1 }))
+}
+
+func TestByte_FromToSet(t *testing.T) {
+	s := NewByte(1, 2, 3)
+
+	generic := FromByte(s)
+	require.True(t, generic.Equal(New[byte](1, 2, 3)))
+
+	require.True(t, ToByte(generic).Equal(s))
+}
@@ -0,0 +1,113 @@
+// Command makeset regenerates a whole family of typed sets from a single
+// manifest, so that a new Byte/Uint64/String-shaped set type can be added
+// without hand-copying one of the existing implementations.
+//
+// Usage:
+//
+//	makeset -manifest manifest.json          # (re)write every entry's files
+//	makeset -manifest manifest.json -check   # fail if any file is out of date
+//
+// The manifest is a JSON array of setgen.ManifestEntry, each pairing a
+// setgen.Schema with the "out" path its implementation is written to; see
+// cmd/makeset/testdata/manifest.json for an example. -check regenerates
+// every entry in memory and diffs it against what's on disk, for use in CI,
+// without writing anything.
+//
+// makeset has no way to know about methods hand-added to a type after it
+// was first generated (this repo's own Byte, Int and Int64 carry
+// codec and Fingerprint methods from chunks 3-5 and 4-1/4-2 that predate
+// this tool and aren't part of the shared template), so no manifest entry
+// here targets those files; use makeset for new element types instead, and
+// fold in any extra hand-written methods as a separate file in the same
+// package.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/things-go/sets/internal/setgen"
+)
+
+func main() {
+	if err := run(os.Args[1:]); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string) error {
+	fs := flag.NewFlagSet("makeset", flag.ContinueOnError)
+	manifestPath := fs.String("manifest", "", "path to a .json manifest file (required)")
+	check := fs.Bool("check", false, "fail instead of writing, if any generated file would differ from what's on disk")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *manifestPath == "" {
+		fs.Usage()
+		return fmt.Errorf("makeset: -manifest is required")
+	}
+
+	manifest, err := setgen.LoadManifest(*manifestPath)
+	if err != nil {
+		return err
+	}
+
+	var stale []string
+	for _, entry := range manifest {
+		implSrc, testSrc, err := setgen.Generate(entry.Schema)
+		if err != nil {
+			return fmt.Errorf("makeset: %s: %w", entry.TypeName, err)
+		}
+		testPath := strings.TrimSuffix(entry.Out, ".go") + "_test.go"
+
+		if *check {
+			outOfDate, err := differsOnDisk(entry.Out, implSrc)
+			if err != nil {
+				return err
+			}
+			if outOfDate {
+				stale = append(stale, entry.Out)
+			}
+			if testSrc != nil {
+				outOfDate, err := differsOnDisk(testPath, testSrc)
+				if err != nil {
+					return err
+				}
+				if outOfDate {
+					stale = append(stale, testPath)
+				}
+			}
+			continue
+		}
+
+		if err := os.WriteFile(entry.Out, implSrc, 0o644); err != nil {
+			return fmt.Errorf("makeset: writing %s: %w", entry.Out, err)
+		}
+		if testSrc != nil {
+			if err := os.WriteFile(testPath, testSrc, 0o644); err != nil {
+				return fmt.Errorf("makeset: writing %s: %w", testPath, err)
+			}
+		}
+	}
+
+	if len(stale) > 0 {
+		return fmt.Errorf("makeset: out of date with the manifest, re-run makeset without -check: %s", strings.Join(stale, ", "))
+	}
+	return nil
+}
+
+// differsOnDisk reports whether the file at path is missing or differs from want.
+func differsOnDisk(path string, want []byte) (bool, error) {
+	got, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return true, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("makeset: reading %s: %w", path, err)
+	}
+	return !bytes.Equal(got, want), nil
+}
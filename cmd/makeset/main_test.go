@@ -0,0 +1,78 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeManifest(t *testing.T, dir string, out string) string {
+	t.Helper()
+	manifest := []map[string]interface{}{
+		{
+			"package":      "demo",
+			"type_name":    "Demo",
+			"element_type": "int",
+			"less":         "lhs < rhs",
+			"examples":     []string{"1", "2", "3"},
+			"out":          out,
+		},
+	}
+	data, err := json.Marshal(manifest)
+	if err != nil {
+		t.Fatalf("marshal manifest: %v", err)
+	}
+	path := filepath.Join(dir, "manifest.json")
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		t.Fatalf("write manifest: %v", err)
+	}
+	return path
+}
+
+func TestRunGeneratesImplAndTest(t *testing.T) {
+	dir := t.TempDir()
+	outPath := filepath.Join(dir, "demo_set.go")
+	manifestPath := writeManifest(t, dir, outPath)
+
+	if err := run([]string{"-manifest", manifestPath}); err != nil {
+		t.Fatalf("run: %v", err)
+	}
+
+	if _, err := os.Stat(outPath); err != nil {
+		t.Errorf("expected %s to be written: %v", outPath, err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "demo_set_test.go")); err != nil {
+		t.Errorf("expected demo_set_test.go to be written: %v", err)
+	}
+}
+
+func TestRunCheckDetectsDrift(t *testing.T) {
+	dir := t.TempDir()
+	outPath := filepath.Join(dir, "demo_set.go")
+	manifestPath := writeManifest(t, dir, outPath)
+
+	if err := run([]string{"-manifest", manifestPath, "-check"}); err == nil {
+		t.Error("expected -check to fail when nothing has been generated yet")
+	}
+
+	if err := run([]string{"-manifest", manifestPath}); err != nil {
+		t.Fatalf("run: %v", err)
+	}
+	if err := run([]string{"-manifest", manifestPath, "-check"}); err != nil {
+		t.Errorf("expected -check to pass right after generating, got %v", err)
+	}
+
+	if err := os.WriteFile(outPath, []byte("package demo\n"), 0o644); err != nil {
+		t.Fatalf("corrupt generated file: %v", err)
+	}
+	if err := run([]string{"-manifest", manifestPath, "-check"}); err == nil {
+		t.Error("expected -check to fail after the generated file was hand-edited")
+	}
+}
+
+func TestRunRequiresManifestFlag(t *testing.T) {
+	if err := run(nil); err == nil {
+		t.Error("expected an error when -manifest is omitted")
+	}
+}
@@ -0,0 +1,66 @@
+// Command setsgen generates a full set implementation for a user-declared
+// element type, mirroring the hand-duplicated Byte/Int/Int64 files in
+// package sets but without the copy-paste maintenance cost: Insert, Delete,
+// Contains*, Union, Intersection, Difference, Diff, DiffVary, Merge, Each,
+// Clone, List, UnsortedList and Pop, generated once per schema.
+//
+// Usage:
+//
+//	setsgen -schema uuid.json -out uuid_set.go
+//
+// The schema (JSON or TOML, see setgen.Schema) names the element type, the
+// package it belongs to, and the "less" expression used to order List's
+// output. The template and schema format are shared with cmd/makeset,
+// which regenerates a whole manifest of set types in one pass and can
+// check committed files for drift; see internal/setgen.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/things-go/sets/internal/setgen"
+)
+
+func main() {
+	if err := run(os.Args[1:]); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string) error {
+	fs := flag.NewFlagSet("setsgen", flag.ContinueOnError)
+	schemaPath := fs.String("schema", "", "path to a .json or .toml schema file (required)")
+	outPath := fs.String("out", "", "path to write the generated implementation (required)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *schemaPath == "" || *outPath == "" {
+		fs.Usage()
+		return fmt.Errorf("setsgen: both -schema and -out are required")
+	}
+
+	schema, err := setgen.LoadSchema(*schemaPath)
+	if err != nil {
+		return err
+	}
+
+	implSrc, testSrc, err := setgen.Generate(schema)
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(*outPath, implSrc, 0o644); err != nil {
+		return fmt.Errorf("setsgen: writing %s: %w", *outPath, err)
+	}
+	if testSrc != nil {
+		testPath := strings.TrimSuffix(*outPath, ".go") + "_test.go"
+		if err := os.WriteFile(testPath, testSrc, 0o644); err != nil {
+			return fmt.Errorf("setsgen: writing %s: %w", testPath, err)
+		}
+	}
+	return nil
+}
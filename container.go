@@ -0,0 +1,206 @@
+package sets
+
+import (
+	"math/bits"
+	"sort"
+)
+
+// containerThreshold is the cardinality above which a container switches
+// from a sorted array to a bitmap representation.
+const containerThreshold = 4096
+
+// containerBitmapWords is the number of uint64 words needed to address all
+// 65536 positions a 16-bit container key can hold (65536/64).
+const containerBitmapWords = 1024
+
+// container holds the low 16 bits of every value sharing a chunk key,
+// either as a sorted array (cheap for sparse chunks) or as a bitmap (cheap,
+// in both memory and per-op cost, once the chunk is dense). Exactly one of
+// array or bitmap is non-nil at any time.
+type container struct {
+	array  []uint16
+	bitmap []uint64
+}
+
+func newArrayContainer() *container {
+	return &container{array: []uint16{}}
+}
+
+func (c *container) isBitmap() bool {
+	return c.bitmap != nil
+}
+
+func (c *container) len() int {
+	if c.isBitmap() {
+		n := 0
+		for _, w := range c.bitmap {
+			n += bits.OnesCount64(w)
+		}
+		return n
+	}
+	return len(c.array)
+}
+
+func (c *container) toBitmap() {
+	bm := make([]uint64, containerBitmapWords)
+	for _, v := range c.array {
+		bm[v/64] |= 1 << (v % 64)
+	}
+	c.array = nil
+	c.bitmap = bm
+}
+
+// insert adds v to the container, converting to a bitmap if the array
+// representation would exceed containerThreshold. Returns true if v was
+// newly added.
+func (c *container) insert(v uint16) bool {
+	if c.isBitmap() {
+		word, bit := v/64, uint64(1)<<(v%64)
+		if c.bitmap[word]&bit != 0 {
+			return false
+		}
+		c.bitmap[word] |= bit
+		return true
+	}
+	i := sort.Search(len(c.array), func(i int) bool { return c.array[i] >= v })
+	if i < len(c.array) && c.array[i] == v {
+		return false
+	}
+	c.array = append(c.array, 0)
+	copy(c.array[i+1:], c.array[i:])
+	c.array[i] = v
+	if len(c.array) > containerThreshold {
+		c.toBitmap()
+	}
+	return true
+}
+
+// remove deletes v from the container, reporting whether it was present.
+func (c *container) remove(v uint16) bool {
+	if c.isBitmap() {
+		word, bit := v/64, uint64(1)<<(v%64)
+		if c.bitmap[word]&bit == 0 {
+			return false
+		}
+		c.bitmap[word] &^= bit
+		return true
+	}
+	i := sort.Search(len(c.array), func(i int) bool { return c.array[i] >= v })
+	if i >= len(c.array) || c.array[i] != v {
+		return false
+	}
+	c.array = append(c.array[:i], c.array[i+1:]...)
+	return true
+}
+
+func (c *container) contains(v uint16) bool {
+	if c.isBitmap() {
+		return c.bitmap[v/64]&(1<<(v%64)) != 0
+	}
+	i := sort.Search(len(c.array), func(i int) bool { return c.array[i] >= v })
+	return i < len(c.array) && c.array[i] == v
+}
+
+// values returns every set position, in ascending order.
+func (c *container) values() []uint16 {
+	if !c.isBitmap() {
+		return c.array
+	}
+	res := make([]uint16, 0, c.len())
+	for i, w := range c.bitmap {
+		for w != 0 {
+			b := bits.TrailingZeros64(w)
+			res = append(res, uint16(i*64+b))
+			w &= w - 1
+		}
+	}
+	return res
+}
+
+func (c *container) clone() *container {
+	nc := &container{}
+	if c.isBitmap() {
+		nc.bitmap = append([]uint64(nil), c.bitmap...)
+	} else {
+		nc.array = append([]uint16{}, c.array...)
+	}
+	return nc
+}
+
+// union returns a new container holding every value in c or other,
+// combining via bitwise OR when either side is already a bitmap.
+func (c *container) union(other *container) *container {
+	if c.isBitmap() || other.isBitmap() {
+		a, b := c.clone(), other
+		if !a.isBitmap() {
+			a.toBitmap()
+		}
+		for i := range a.bitmap {
+			if other.isBitmap() {
+				a.bitmap[i] |= other.bitmap[i]
+			}
+		}
+		if !other.isBitmap() {
+			for _, v := range b.array {
+				a.insert(v)
+			}
+		}
+		return a
+	}
+	result := newArrayContainer()
+	seen := make(map[uint16]struct{}, len(c.array)+len(other.array))
+	for _, v := range c.array {
+		seen[v] = struct{}{}
+	}
+	for _, v := range other.array {
+		seen[v] = struct{}{}
+	}
+	for v := range seen {
+		result.insert(v)
+	}
+	return result
+}
+
+// intersect returns a new container holding every value in both c and
+// other, combining via bitwise AND when both sides are already bitmaps
+// instead of walking the smaller side's values one at a time.
+func (c *container) intersect(other *container) *container {
+	if c.isBitmap() && other.isBitmap() {
+		bm := make([]uint64, containerBitmapWords)
+		for i := range bm {
+			bm[i] = c.bitmap[i] & other.bitmap[i]
+		}
+		return &container{bitmap: bm}
+	}
+	result := newArrayContainer()
+	small, big := c, other
+	if small.len() > big.len() {
+		small, big = big, small
+	}
+	for _, v := range small.values() {
+		if big.contains(v) {
+			result.insert(v)
+		}
+	}
+	return result
+}
+
+// diff returns a new container holding every value in c that is not in
+// other, combining via bitwise AND-NOT when both sides are already
+// bitmaps.
+func (c *container) diff(other *container) *container {
+	if c.isBitmap() && other.isBitmap() {
+		bm := make([]uint64, containerBitmapWords)
+		for i := range bm {
+			bm[i] = c.bitmap[i] &^ other.bitmap[i]
+		}
+		return &container{bitmap: bm}
+	}
+	result := newArrayContainer()
+	for _, v := range c.values() {
+		if !other.contains(v) {
+			result.insert(v)
+		}
+	}
+	return result
+}
@@ -0,0 +1,28 @@
+package sets
+
+import (
+	"fmt"
+	"hash/fnv"
+)
+
+// mix64 applies a SplitMix64-style finalizer to avalanche its input bits.
+// It backs Fingerprint on the numeric set types, where XORing raw values
+// together would make different sets collide trivially (e.g. {1, 2} and {3}
+// both XOR to 3).
+func mix64(v uint64) uint64 {
+	v ^= v >> 30
+	v *= 0xbf58476d1ce4e5b9
+	v ^= v >> 27
+	v *= 0x94d049bb133111eb
+	v ^= v >> 31
+	return v
+}
+
+// fingerprintElement hashes an arbitrary comparable value via its fmt
+// representation, for use by Set[T].Fingerprint where T carries no numeric
+// or Ordered constraint to hash more directly.
+func fingerprintElement[T any](item T) uint64 {
+	h := fnv.New64a()
+	fmt.Fprintf(h, "%#v", item)
+	return h.Sum64()
+}
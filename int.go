@@ -17,8 +17,12 @@ limitations under the License.
 package sets
 
 import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
 	"reflect"
 	"sort"
+	"strconv"
 )
 
 // Int is a set of ints, implemented via map[int]struct{} for minimal memory consumption.
@@ -243,3 +247,151 @@ func (s Int) Clone() Int {
 	})
 	return ns
 }
+
+// UnionInplace adds every item of s2 into the receiver and returns it,
+// avoiding the fresh map that Union allocates.
+func (s Int) UnionInplace(s2 Int) Int {
+	for key := range s2 {
+		s[key] = struct{}{}
+	}
+	return s
+}
+
+// IntersectionInplace removes from the receiver every item not in s2 and
+// returns it, avoiding the fresh map that Intersection allocates. When s2 is
+// smaller than the receiver, it collects the common items by walking s2, then
+// clears the receiver and refills it with them.
+func (s Int) IntersectionInplace(s2 Int) Int {
+	if len(s2) >= len(s) {
+		for key := range s {
+			if !s2.Contains(key) {
+				delete(s, key)
+			}
+		}
+		return s
+	}
+	keep := NewInt()
+	for key := range s2 {
+		if s.Contains(key) {
+			keep[key] = struct{}{}
+		}
+	}
+	for key := range s {
+		delete(s, key)
+	}
+	for key := range keep {
+		s[key] = struct{}{}
+	}
+	return s
+}
+
+// DifferenceInplace removes from the receiver every item also in s2 and
+// returns it, avoiding the fresh map that Difference allocates.
+func (s Int) DifferenceInplace(s2 Int) Int {
+	for key := range s2 {
+		delete(s, key)
+	}
+	return s
+}
+
+// RetainAll keeps only the items in items, removing everything else from the
+// receiver, and returns it for chaining.
+func (s Int) RetainAll(items ...int) Int {
+	return s.IntersectionInplace(NewInt(items...))
+}
+
+// RemoveAll is an alias for Delete, removing items from the receiver and
+// returning it for chaining.
+func (s Int) RemoveAll(items ...int) Int {
+	return s.Delete(items...)
+}
+
+// MarshalJSON implements json.Marshaler, encoding the set as a sorted JSON
+// array for deterministic output.
+func (s Int) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.List())
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It accepts either a JSON array
+// of ints, or an object whose keys parse as ints (values are ignored).
+func (s *Int) UnmarshalJSON(data []byte) error {
+	var items []int
+	if err := json.Unmarshal(data, &items); err == nil {
+		if err := checkDuplicates(items); err != nil {
+			return err
+		}
+		*s = NewInt(items...)
+		return nil
+	}
+	m := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &m); err != nil {
+		return err
+	}
+	ret := NewInt()
+	for k := range m {
+		v, err := strconv.Atoi(k)
+		if err != nil {
+			return err
+		}
+		ret.Insert(v)
+	}
+	*s = ret
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder.
+func (s Int) GobEncode() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(s.List()); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// GobDecode implements gob.GobDecoder.
+func (s *Int) GobDecode(data []byte) error {
+	var items []int
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&items); err != nil {
+		return err
+	}
+	*s = NewInt(items...)
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, using a compact
+// length-prefixed varint stream (zig-zag encoded, since values may be
+// negative) instead of gob's heavier type-descriptor framing.
+func (s Int) MarshalBinary() ([]byte, error) {
+	items := s.List()
+	words := make([]uint64, len(items))
+	for i, item := range items {
+		words[i] = zigzagEncode(int64(item))
+	}
+	return encodeUvarints(words), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *Int) UnmarshalBinary(data []byte) error {
+	words, err := decodeUvarints(data)
+	if err != nil {
+		return err
+	}
+	ret := NewInt()
+	for _, w := range words {
+		ret.Insert(int(zigzagDecode(w)))
+	}
+	*s = ret
+	return nil
+}
+
+// Fingerprint returns an order-independent hash of the set's contents,
+// suitable for cheaply comparing or caching set states: two Int sets with
+// identical membership always produce the same fingerprint, regardless of
+// map iteration order.
+func (s Int) Fingerprint() uint64 {
+	var fp uint64
+	for item := range s {
+		fp ^= mix64(zigzagEncode(int64(item)))
+	}
+	return fp
+}
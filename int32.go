@@ -17,6 +17,9 @@ limitations under the License.
 package sets
 
 import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
 	"reflect"
 	"sort"
 )
@@ -243,3 +246,41 @@ func (s Int32) Clone() Int32 {
 	})
 	return ns
 }
+
+// MarshalJSON implements json.Marshaler, encoding the set as a sorted JSON
+// array for deterministic output.
+func (s Int32) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.List())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (s *Int32) UnmarshalJSON(data []byte) error {
+	var items []int32
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+	if err := checkDuplicates(items); err != nil {
+		return err
+	}
+	*s = NewInt32(items...)
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder.
+func (s Int32) GobEncode() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(s.List()); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// GobDecode implements gob.GobDecoder.
+func (s *Int32) GobDecode(data []byte) error {
+	var items []int32
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&items); err != nil {
+		return err
+	}
+	*s = NewInt32(items...)
+	return nil
+}
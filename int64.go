@@ -17,6 +17,9 @@ limitations under the License.
 package sets
 
 import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
 	"reflect"
 	"sort"
 )
@@ -243,3 +246,87 @@ func (s Int64) Clone() Int64 {
 	})
 	return ns
 }
+
+// MarshalJSON implements json.Marshaler, encoding the set as a sorted JSON
+// array for deterministic output.
+func (s Int64) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.List())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (s *Int64) UnmarshalJSON(data []byte) error {
+	var items []int64
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+	if err := checkDuplicates(items); err != nil {
+		return err
+	}
+	*s = NewInt64(items...)
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder.
+func (s Int64) GobEncode() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(s.List()); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// GobDecode implements gob.GobDecoder.
+func (s *Int64) GobDecode(data []byte) error {
+	var items []int64
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&items); err != nil {
+		return err
+	}
+	*s = NewInt64(items...)
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, using a compact
+// length-prefixed varint stream (zig-zag encoded, since values may be
+// negative) instead of gob's heavier type-descriptor framing.
+func (s Int64) MarshalBinary() ([]byte, error) {
+	items := s.List()
+	words := make([]uint64, len(items))
+	for i, item := range items {
+		words[i] = zigzagEncode(item)
+	}
+	return encodeUvarints(words), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *Int64) UnmarshalBinary(data []byte) error {
+	words, err := decodeUvarints(data)
+	if err != nil {
+		return err
+	}
+	ret := NewInt64()
+	for _, w := range words {
+		ret.Insert(zigzagDecode(w))
+	}
+	*s = ret
+	return nil
+}
+
+func zigzagEncode(v int64) uint64 {
+	return uint64(v<<1) ^ uint64(v>>63)
+}
+
+func zigzagDecode(v uint64) int64 {
+	return int64(v>>1) ^ -int64(v&1)
+}
+
+// Fingerprint returns an order-independent hash of the set's contents,
+// suitable for cheaply comparing or caching set states: two Int64 sets with
+// identical membership always produce the same fingerprint, regardless of
+// map iteration order.
+func (s Int64) Fingerprint() uint64 {
+	var fp uint64
+	for item := range s {
+		fp ^= mix64(zigzagEncode(item))
+	}
+	return fp
+}
@@ -0,0 +1,236 @@
+package sets
+
+import "sort"
+
+// int64Interval is a half-open range [lo, hi) of int64 values.
+type int64Interval struct {
+	lo, hi int64
+}
+
+// Int64Range is a set of int64s stored as a sorted slice of non-overlapping,
+// non-adjacent half-open intervals rather than one map entry per element,
+// like Int64 does. It is the right choice for dense contiguous key spaces
+// -- tracked BTree keys, port ranges, ID allocations -- where the number of
+// elements can vastly exceed the number of contiguous runs: InsertRange,
+// DeleteRange and the set operations all cost O(#intervals), not
+// O(#elements).
+type Int64Range struct {
+	intervals []int64Interval
+}
+
+// NewInt64Range creates an empty Int64Range.
+func NewInt64Range() *Int64Range {
+	return &Int64Range{}
+}
+
+// Insert adds individual items to the set.
+func (s *Int64Range) Insert(items ...int64) *Int64Range {
+	for _, item := range items {
+		s.InsertRange(item, item+1)
+	}
+	return s
+}
+
+// Delete removes individual items from the set.
+func (s *Int64Range) Delete(items ...int64) *Int64Range {
+	for _, item := range items {
+		s.DeleteRange(item, item+1)
+	}
+	return s
+}
+
+// InsertRange adds every value in [lo, hi) to the set, coalescing it with
+// any overlapping or adjacent intervals already present. A lo >= hi range
+// is a no-op.
+func (s *Int64Range) InsertRange(lo, hi int64) *Int64Range {
+	if lo >= hi {
+		return s
+	}
+	// i is the first interval that could overlap or touch [lo, hi); j is
+	// one past the last such interval.
+	i := sort.Search(len(s.intervals), func(i int) bool { return s.intervals[i].hi >= lo })
+	j := i
+	for j < len(s.intervals) && s.intervals[j].lo <= hi {
+		if s.intervals[j].lo < lo {
+			lo = s.intervals[j].lo
+		}
+		if s.intervals[j].hi > hi {
+			hi = s.intervals[j].hi
+		}
+		j++
+	}
+	merged := make([]int64Interval, 0, len(s.intervals)-(j-i)+1)
+	merged = append(merged, s.intervals[:i]...)
+	merged = append(merged, int64Interval{lo, hi})
+	merged = append(merged, s.intervals[j:]...)
+	s.intervals = merged
+	return s
+}
+
+// DeleteRange removes every value in [lo, hi) from the set, splitting any
+// interval it partially overlaps. A lo >= hi range is a no-op.
+func (s *Int64Range) DeleteRange(lo, hi int64) *Int64Range {
+	if lo >= hi {
+		return s
+	}
+	result := make([]int64Interval, 0, len(s.intervals))
+	for _, iv := range s.intervals {
+		if iv.hi <= lo || iv.lo >= hi {
+			result = append(result, iv)
+			continue
+		}
+		if iv.lo < lo {
+			result = append(result, int64Interval{iv.lo, lo})
+		}
+		if iv.hi > hi {
+			result = append(result, int64Interval{hi, iv.hi})
+		}
+	}
+	s.intervals = result
+	return s
+}
+
+// Contains returns true if and only if item is contained in the set.
+func (s *Int64Range) Contains(item int64) bool {
+	return s.ContainsRange(item, item+1)
+}
+
+// ContainsRange returns true if and only if every value in [lo, hi) is
+// contained in the set. A lo >= hi range is trivially contained.
+func (s *Int64Range) ContainsRange(lo, hi int64) bool {
+	if lo >= hi {
+		return true
+	}
+	i := sort.Search(len(s.intervals), func(i int) bool { return s.intervals[i].hi > lo })
+	return i < len(s.intervals) && s.intervals[i].lo <= lo && s.intervals[i].hi >= hi
+}
+
+// Len returns the number of individual int64 values in the set, which may
+// vastly exceed the number of intervals backing it.
+func (s *Int64Range) Len() int64 {
+	var n int64
+	for _, iv := range s.intervals {
+		n += iv.hi - iv.lo
+	}
+	return n
+}
+
+// Ranges returns the set's coalesced intervals, each as a [lo, hi) pair, in
+// ascending order.
+func (s *Int64Range) Ranges() [][2]int64 {
+	res := make([][2]int64, len(s.intervals))
+	for i, iv := range s.intervals {
+		res[i] = [2]int64{iv.lo, iv.hi}
+	}
+	return res
+}
+
+// EachRange visits every coalesced interval in ascending order, calling f
+// with its [lo, hi) bounds. Traversal stops early if f returns false.
+func (s *Int64Range) EachRange(f func(lo, hi int64) bool) {
+	for _, iv := range s.intervals {
+		if !f(iv.lo, iv.hi) {
+			return
+		}
+	}
+}
+
+// Clone returns a new Int64Range with a copy of s.
+func (s *Int64Range) Clone() *Int64Range {
+	return &Int64Range{intervals: append([]int64Interval(nil), s.intervals...)}
+}
+
+// Union returns a new Int64Range which includes every value in either s or
+// s2, in O(#intervals in s + #intervals in s2).
+func (s *Int64Range) Union(s2 *Int64Range) *Int64Range {
+	result := NewInt64Range()
+	i, j := 0, 0
+	for i < len(s.intervals) || j < len(s2.intervals) {
+		var next int64Interval
+		switch {
+		case i >= len(s.intervals):
+			next, j = s2.intervals[j], j+1
+		case j >= len(s2.intervals):
+			next, i = s.intervals[i], i+1
+		case s.intervals[i].lo <= s2.intervals[j].lo:
+			next, i = s.intervals[i], i+1
+		default:
+			next, j = s2.intervals[j], j+1
+		}
+		// Intervals arrive in ascending lo order, so next can only overlap
+		// or touch the last interval appended so far.
+		if n := len(result.intervals); n > 0 && next.lo <= result.intervals[n-1].hi {
+			if next.hi > result.intervals[n-1].hi {
+				result.intervals[n-1].hi = next.hi
+			}
+		} else {
+			result.intervals = append(result.intervals, next)
+		}
+	}
+	return result
+}
+
+// Intersection returns a new Int64Range which includes every value present
+// in both s and s2, in O(#intervals in s + #intervals in s2).
+func (s *Int64Range) Intersection(s2 *Int64Range) *Int64Range {
+	result := NewInt64Range()
+	i, j := 0, 0
+	for i < len(s.intervals) && j < len(s2.intervals) {
+		a, b := s.intervals[i], s2.intervals[j]
+		lo, hi := a.lo, a.hi
+		if b.lo > lo {
+			lo = b.lo
+		}
+		if b.hi < hi {
+			hi = b.hi
+		}
+		if lo < hi {
+			result.intervals = append(result.intervals, int64Interval{lo, hi})
+		}
+		if a.hi < b.hi {
+			i++
+		} else {
+			j++
+		}
+	}
+	return result
+}
+
+// Difference returns a new Int64Range of values in s that are not in s2, in
+// O(#intervals in s + #intervals in s2).
+func (s *Int64Range) Difference(s2 *Int64Range) *Int64Range {
+	result := NewInt64Range()
+	j := 0
+	for _, a := range s.intervals {
+		lo := a.lo
+		for j < len(s2.intervals) && s2.intervals[j].hi <= lo {
+			j++
+		}
+		for k := j; k < len(s2.intervals) && s2.intervals[k].lo < a.hi; k++ {
+			b := s2.intervals[k]
+			if b.lo > lo {
+				result.intervals = append(result.intervals, int64Interval{lo, b.lo})
+			}
+			if b.hi > lo {
+				lo = b.hi
+			}
+		}
+		if lo < a.hi {
+			result.intervals = append(result.intervals, int64Interval{lo, a.hi})
+		}
+	}
+	return result
+}
+
+// Equal returns true if and only if s is equal (as a set) to s2.
+func (s *Int64Range) Equal(s2 *Int64Range) bool {
+	if len(s.intervals) != len(s2.intervals) {
+		return false
+	}
+	for i, iv := range s.intervals {
+		if iv != s2.intervals[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,88 @@
+package sets
+
+import "testing"
+
+func TestInt64Range_InsertContainsDelete(t *testing.T) {
+	s := NewInt64Range()
+	s.InsertRange(10, 20)
+	if s.Len() != 10 {
+		t.Errorf("Expected len=10: %d", s.Len())
+	}
+	if !s.ContainsRange(10, 20) || !s.Contains(15) {
+		t.Errorf("Missing contents: %v", s.Ranges())
+	}
+	if s.Contains(20) || s.Contains(9) {
+		t.Errorf("Unexpected contents: %v", s.Ranges())
+	}
+	s.DeleteRange(12, 15)
+	if s.Contains(12) || s.Contains(14) || !s.Contains(11) || !s.Contains(15) {
+		t.Errorf("Unexpected contents after split: %v", s.Ranges())
+	}
+	if want := [][2]int64{{10, 12}, {15, 20}}; !rangesEqual(s.Ranges(), want) {
+		t.Errorf("Expected %v, got %v", want, s.Ranges())
+	}
+}
+
+func TestInt64Range_Coalescing(t *testing.T) {
+	s := NewInt64Range()
+	s.InsertRange(0, 5)
+	s.InsertRange(10, 15)
+	s.InsertRange(5, 10)
+	if want := [][2]int64{{0, 15}}; !rangesEqual(s.Ranges(), want) {
+		t.Errorf("Expected coalesced range %v, got %v", want, s.Ranges())
+	}
+}
+
+func TestInt64Range_InsertSingleValues(t *testing.T) {
+	s := NewInt64Range()
+	s.Insert(1, 2, 3, 10)
+	if want := [][2]int64{{1, 4}, {10, 11}}; !rangesEqual(s.Ranges(), want) {
+		t.Errorf("Expected %v, got %v", want, s.Ranges())
+	}
+	s.Delete(2)
+	if want := [][2]int64{{1, 2}, {3, 4}, {10, 11}}; !rangesEqual(s.Ranges(), want) {
+		t.Errorf("Expected %v, got %v", want, s.Ranges())
+	}
+}
+
+func TestInt64Range_SetOps(t *testing.T) {
+	a := NewInt64Range()
+	a.InsertRange(0, 10)
+	b := NewInt64Range()
+	b.InsertRange(5, 15)
+
+	if want := [][2]int64{{0, 15}}; !rangesEqual(a.Union(b).Ranges(), want) {
+		t.Errorf("Unexpected union: %v", a.Union(b).Ranges())
+	}
+	if want := [][2]int64{{5, 10}}; !rangesEqual(a.Intersection(b).Ranges(), want) {
+		t.Errorf("Unexpected intersection: %v", a.Intersection(b).Ranges())
+	}
+	if want := [][2]int64{{0, 5}}; !rangesEqual(a.Difference(b).Ranges(), want) {
+		t.Errorf("Unexpected difference: %v", a.Difference(b).Ranges())
+	}
+}
+
+func TestInt64Range_EqualAndClone(t *testing.T) {
+	a := NewInt64Range()
+	a.InsertRange(1, 5)
+	b := a.Clone()
+	if !a.Equal(b) {
+		t.Errorf("Clone should equal original")
+	}
+	b.InsertRange(10, 20)
+	if a.Equal(b) {
+		t.Errorf("Clone should not share storage with original")
+	}
+}
+
+func rangesEqual(a, b [][2]int64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
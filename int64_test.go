@@ -343,9 +343,9 @@ func TestInt64Merge(t *testing.T) {
 func TestInt64_Each(t *testing.T) {
 	expect := NewInt64(1, 2, 3, 4)
 	s1 := NewInt64(1, 2, 3, 4)
-	s1.Each(func(item interface{}) bool {
-		require.True(t, expect.Contains(item.(int64)))
-		return item.(int64) != 3
+	s1.Each(func(item int64) bool {
+		require.True(t, expect.Contains(item))
+		return item != 3
 	})
 }
 
@@ -355,3 +355,42 @@ func TestInt64_Clone(t *testing.T) {
 
 	require.True(t, s1.Equal(s2))
 }
+
+func TestInt64_JSONRoundTrip(t *testing.T) {
+	s := NewInt64(3, -1, 2)
+	data, err := s.MarshalJSON()
+	require.NoError(t, err)
+
+	var got Int64
+	require.NoError(t, got.UnmarshalJSON(data))
+	require.True(t, got.Equal(s))
+}
+
+func TestInt64_BinaryRoundTrip(t *testing.T) {
+	s := NewInt64(3, -1, 2, -100000)
+	data, err := s.MarshalBinary()
+	require.NoError(t, err)
+
+	var got Int64
+	require.NoError(t, got.UnmarshalBinary(data))
+	require.True(t, got.Equal(s))
+}
+
+func TestInt64_GobRoundTrip(t *testing.T) {
+	s := NewInt64(3, -1, 2)
+	data, err := s.GobEncode()
+	require.NoError(t, err)
+
+	var got Int64
+	require.NoError(t, got.GobDecode(data))
+	require.True(t, got.Equal(s))
+}
+
+func TestInt64_Fingerprint(t *testing.T) {
+	a := NewInt64(1, -2, 3)
+	b := NewInt64(3, -2, 1)
+	require.Equal(t, a.Fingerprint(), b.Fingerprint())
+
+	c := NewInt64(1, -2, 4)
+	require.NotEqual(t, a.Fingerprint(), c.Fingerprint())
+}
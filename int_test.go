@@ -350,9 +350,68 @@ func TestInt_Each(t *testing.T) {
 	})
 }
 
+func TestInt_InplaceOps(t *testing.T) {
+	a := NewInt(1, 2, 3)
+	b := NewInt(2, 3, 4)
+
+	a.UnionInplace(b)
+	require.True(t, a.Equal(NewInt(1, 2, 3, 4)))
+
+	a.IntersectionInplace(NewInt(2, 3))
+	require.True(t, a.Equal(NewInt(2, 3)))
+
+	a.DifferenceInplace(NewInt(3))
+	require.True(t, a.Equal(NewInt(2)))
+
+	c := NewInt(1, 2, 3, 4, 5)
+	c.RetainAll(2, 4)
+	require.True(t, c.Equal(NewInt(2, 4)))
+	c.RemoveAll(4)
+	require.True(t, c.Equal(NewInt(2)))
+}
+
 func TestInt_Clone(t *testing.T) {
 	s1 := NewInt(1, 2, 3, 4)
 	s2 := s1.Clone()
 
 	require.True(t, s1.Equal(s2))
 }
+
+func TestInt_JSONRoundTrip(t *testing.T) {
+	s := NewInt(3, -1, 2)
+	data, err := s.MarshalJSON()
+	require.NoError(t, err)
+
+	var got Int
+	require.NoError(t, got.UnmarshalJSON(data))
+	require.True(t, got.Equal(s))
+}
+
+func TestInt_GobRoundTrip(t *testing.T) {
+	s := NewInt(3, -1, 2)
+	data, err := s.GobEncode()
+	require.NoError(t, err)
+
+	var got Int
+	require.NoError(t, got.GobDecode(data))
+	require.True(t, got.Equal(s))
+}
+
+func TestInt_BinaryRoundTrip(t *testing.T) {
+	s := NewInt(3, -1, 2, -100000)
+	data, err := s.MarshalBinary()
+	require.NoError(t, err)
+
+	var got Int
+	require.NoError(t, got.UnmarshalBinary(data))
+	require.True(t, got.Equal(s))
+}
+
+func TestInt_Fingerprint(t *testing.T) {
+	a := NewInt(1, -2, 3)
+	b := NewInt(3, -2, 1)
+	require.Equal(t, a.Fingerprint(), b.Fingerprint())
+
+	c := NewInt(1, -2, 4)
+	require.NotEqual(t, a.Fingerprint(), c.Fingerprint())
+}
@@ -0,0 +1,51 @@
+package setgen
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"go/format"
+	"text/template"
+)
+
+//go:embed templates/set.go.tmpl templates/set_test.go.tmpl
+var templateFS embed.FS
+
+var (
+	implTemplate = template.Must(template.ParseFS(templateFS, "templates/set.go.tmpl"))
+	testTemplate = template.Must(template.ParseFS(templateFS, "templates/set_test.go.tmpl"))
+)
+
+// Generate renders the set implementation for schema, and its test file
+// when schema has at least 3 Examples. testSrc is nil when no test is
+// generated.
+func Generate(schema Schema) (implSrc, testSrc []byte, err error) {
+	implSrc, err = render(implTemplate, schema)
+	if err != nil {
+		return nil, nil, fmt.Errorf("rendering %s: %w", schema.TypeName, err)
+	}
+
+	if len(schema.Examples) == 0 {
+		return implSrc, nil, nil
+	}
+	if len(schema.Examples) < 3 {
+		return nil, nil, fmt.Errorf("schema %s: examples must have at least 3 entries, or be omitted entirely", schema.TypeName)
+	}
+	testSrc, err = render(testTemplate, schema)
+	if err != nil {
+		return nil, nil, fmt.Errorf("rendering %s test: %w", schema.TypeName, err)
+	}
+	return implSrc, testSrc, nil
+}
+
+func render(tmpl *template.Template, schema Schema) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, schema); err != nil {
+		return nil, err
+	}
+	formatted, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("gofmt: %w\n%s", err, buf.String())
+	}
+	return formatted, nil
+}
@@ -0,0 +1,69 @@
+package setgen
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGenerateWithoutExamples(t *testing.T) {
+	schema, err := (Schema{
+		Package:     "sets",
+		TypeName:    "Demo",
+		ElementType: "int",
+		Less:        "lhs < rhs",
+	}).withDefaults()
+	if err != nil {
+		t.Fatalf("withDefaults: %v", err)
+	}
+
+	implSrc, testSrc, err := Generate(schema)
+	if err != nil {
+		t.Fatalf("Generate: %v", err)
+	}
+	if testSrc != nil {
+		t.Errorf("expected no test file without Examples, got %d bytes", len(testSrc))
+	}
+	for _, want := range []string{"type Demo map[int]struct{}", "func (s Demo) Diff(", "func (s Demo) DiffVary(", "func (s Demo) Pop() (int, bool)"} {
+		if !strings.Contains(string(implSrc), want) {
+			t.Errorf("generated source missing %q:\n%s", want, implSrc)
+		}
+	}
+}
+
+func TestGenerateWithExamples(t *testing.T) {
+	schema, err := (Schema{
+		Package:     "sets",
+		TypeName:    "Demo",
+		ElementType: "int",
+		Less:        "lhs < rhs",
+		Examples:    []string{"1", "2", "3"},
+	}).withDefaults()
+	if err != nil {
+		t.Fatalf("withDefaults: %v", err)
+	}
+
+	_, testSrc, err := Generate(schema)
+	if err != nil {
+		t.Fatalf("Generate: %v", err)
+	}
+	if !strings.Contains(string(testSrc), "func TestDemoSet(t *testing.T)") {
+		t.Errorf("generated test source missing TestDemoSet:\n%s", testSrc)
+	}
+}
+
+func TestGenerateRejectsTooFewExamples(t *testing.T) {
+	schema, err := (Schema{
+		Package:     "sets",
+		TypeName:    "Demo",
+		ElementType: "int",
+		Less:        "lhs < rhs",
+		Examples:    []string{"1", "2"},
+	}).withDefaults()
+	if err != nil {
+		t.Fatalf("withDefaults: %v", err)
+	}
+
+	if _, _, err := Generate(schema); err == nil {
+		t.Error("expected an error for fewer than 3 examples")
+	}
+}
@@ -0,0 +1,45 @@
+package setgen
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// ManifestEntry is one set type for a makeset run to produce,
+// pairing a Schema with the path its implementation should be written to.
+type ManifestEntry struct {
+	Schema
+	// Out is the path the generated implementation is written to. The test
+	// file, if any, is written alongside it with a "_test.go" suffix.
+	Out string `json:"out"`
+}
+
+// Manifest is an ordered list of set types a single generator run should
+// produce, so a whole family of typed sets can be regenerated from one
+// source of truth instead of hand-copied file by file.
+type Manifest []ManifestEntry
+
+// LoadManifest reads a Manifest from a JSON file.
+func LoadManifest(path string) (Manifest, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var m Manifest
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, fmt.Errorf("setgen: parsing manifest %s: %w", path, err)
+	}
+	for i, entry := range m {
+		schema, err := entry.Schema.withDefaults()
+		if err != nil {
+			return nil, fmt.Errorf("setgen: manifest %s entry %d (%s): %w", path, i, entry.TypeName, err)
+		}
+		if entry.Out == "" {
+			return nil, fmt.Errorf("setgen: manifest %s entry %d (%s): out is required", path, i, entry.TypeName)
+		}
+		m[i].Schema = schema
+	}
+	return m, nil
+}
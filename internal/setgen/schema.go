@@ -0,0 +1,154 @@
+package setgen
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// Schema describes a user-defined set type to generate. It is loaded from a
+// JSON or TOML file; the two formats carry the same fields.
+type Schema struct {
+	// Package is the name of the generated package, e.g. "sets".
+	Package string `json:"package" toml:"package"`
+	// TypeName is the exported name of the generated set type, e.g. "UUID".
+	TypeName string `json:"type_name" toml:"type_name"`
+	// ElementType is the Go type stored in the set, e.g. "uuid.UUID" or "time.Time".
+	ElementType string `json:"element_type" toml:"element_type"`
+	// Imports lists extra import paths ElementType or Less needs, e.g. ["time"].
+	Imports []string `json:"imports" toml:"imports"`
+	// Less is a Go boolean expression over "lhs" and "rhs" (both ElementType)
+	// used to sort List's output, e.g. "lhs.Before(rhs)".
+	Less string `json:"less" toml:"less"`
+	// Zero is a Go literal used as the element Pop returns, alongside false,
+	// when the set is empty. Defaults to the type's zero value.
+	Zero string `json:"zero" toml:"zero"`
+	// Examples is an optional list of distinct Go literal expressions, each
+	// evaluating to an ElementType value. When present, setsgen emits a
+	// smoke test exercising Insert/Delete/Union/Intersection/Difference/
+	// DiffVary/etc. against the first three; when absent, no test file is
+	// generated.
+	Examples []string `json:"examples" toml:"examples"`
+}
+
+// LoadSchema reads a Schema from path, dispatching on its extension.
+func LoadSchema(path string) (Schema, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Schema{}, err
+	}
+
+	var s Schema
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".json":
+		err = json.Unmarshal(data, &s)
+	case ".toml":
+		err = unmarshalTOML(data, &s)
+	default:
+		return Schema{}, fmt.Errorf("setgen: unsupported schema extension %q (want .json or .toml)", ext)
+	}
+	if err != nil {
+		return Schema{}, fmt.Errorf("setgen: parsing %s: %w", path, err)
+	}
+
+	s, err = s.withDefaults()
+	if err != nil {
+		return Schema{}, fmt.Errorf("setgen: %s: %w", path, err)
+	}
+	return s, nil
+}
+
+// withDefaults validates s and fills in any fields left at their zero value.
+func (s Schema) withDefaults() (Schema, error) {
+	switch {
+	case s.Package == "":
+		return Schema{}, fmt.Errorf("package is required")
+	case s.TypeName == "":
+		return Schema{}, fmt.Errorf("type_name is required")
+	case s.ElementType == "":
+		return Schema{}, fmt.Errorf("element_type is required")
+	case s.Less == "":
+		return Schema{}, fmt.Errorf("less is required")
+	}
+	if s.Zero == "" {
+		s.Zero = fmt.Sprintf("zero%s", s.TypeName)
+	}
+	return s, nil
+}
+
+// unmarshalTOML parses the flat subset of TOML the Schema needs: one
+// "key = value" assignment per line, quoted string and array-of-quoted-string
+// values, and "#" comments. It intentionally does not support tables, since Schema
+// has no nested structure.
+func unmarshalTOML(data []byte, s *Schema) error {
+	fields := map[string]*string{
+		"package":      &s.Package,
+		"type_name":    &s.TypeName,
+		"element_type": &s.ElementType,
+		"less":         &s.Less,
+		"zero":         &s.Zero,
+	}
+
+	for lineNo, rawLine := range strings.Split(string(data), "\n") {
+		line := strings.TrimSpace(rawLine)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return fmt.Errorf("line %d: missing '='", lineNo+1)
+		}
+		key = strings.TrimSpace(key)
+		value = strings.TrimSpace(value)
+
+		if key == "imports" || key == "examples" {
+			items, err := tomlStringArray(value)
+			if err != nil {
+				return fmt.Errorf("line %d: %w", lineNo+1, err)
+			}
+			if key == "imports" {
+				s.Imports = items
+			} else {
+				s.Examples = items
+			}
+			continue
+		}
+
+		dst, known := fields[key]
+		if !known {
+			return fmt.Errorf("line %d: unknown key %q", lineNo+1, key)
+		}
+		unquoted, err := tomlString(value)
+		if err != nil {
+			return fmt.Errorf("line %d: %w", lineNo+1, err)
+		}
+		*dst = unquoted
+	}
+	return nil
+}
+
+func tomlString(value string) (string, error) {
+	return strconv.Unquote(value)
+}
+
+func tomlStringArray(value string) ([]string, error) {
+	if !strings.HasPrefix(value, "[") || !strings.HasSuffix(value, "]") {
+		return nil, fmt.Errorf("expected array, got %q", value)
+	}
+	inner := strings.TrimSpace(value[1 : len(value)-1])
+	if inner == "" {
+		return nil, nil
+	}
+	var out []string
+	for _, part := range strings.Split(inner, ",") {
+		s, err := tomlString(strings.TrimSpace(part))
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, s)
+	}
+	return out, nil
+}
@@ -0,0 +1,37 @@
+package setgen
+
+import "testing"
+
+func TestLoadSchemaJSONAndTOMLAgree(t *testing.T) {
+	fromJSON, err := LoadSchema("testdata/duration.json")
+	if err != nil {
+		t.Fatalf("LoadSchema(json): %v", err)
+	}
+	fromTOML, err := LoadSchema("testdata/duration.toml")
+	if err != nil {
+		t.Fatalf("LoadSchema(toml): %v", err)
+	}
+
+	if fromJSON.TypeName != "Duration" || fromJSON.ElementType != "time.Duration" {
+		t.Errorf("unexpected JSON schema: %+v", fromJSON)
+	}
+	if fromTOML.TypeName != fromJSON.TypeName ||
+		fromTOML.ElementType != fromJSON.ElementType ||
+		fromTOML.Less != fromJSON.Less ||
+		len(fromTOML.Imports) != len(fromJSON.Imports) ||
+		len(fromTOML.Examples) != len(fromJSON.Examples) {
+		t.Errorf("JSON and TOML schemas disagree: %+v vs %+v", fromJSON, fromTOML)
+	}
+}
+
+func TestLoadSchemaRejectsMissingFields(t *testing.T) {
+	if _, err := (Schema{TypeName: "Demo", ElementType: "int", Less: "lhs < rhs"}).withDefaults(); err == nil {
+		t.Error("expected an error for a missing package")
+	}
+}
+
+func TestLoadSchemaUnknownExtension(t *testing.T) {
+	if _, err := LoadSchema("schema.go"); err == nil {
+		t.Error("expected an error for an unsupported extension")
+	}
+}
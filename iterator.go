@@ -0,0 +1,185 @@
+package sets
+
+// Iterator pulls elements one at a time from a set. Unlike Each, which owns
+// the loop and calls back into user code, Iterator lets the caller drive
+// iteration itself (e.g. from inside a for loop or a larger algorithm).
+// Stop releases any resources the iterator holds; it is safe to call Stop
+// more than once and safe to skip if Next has already returned ok==false.
+type Iterator[T any] interface {
+	Next() (T, bool)
+	Stop()
+}
+
+// sliceIterator iterates a pre-materialized slice. Materializing the slice
+// once up front (via List) is far cheaper than the repeated intermediate
+// map allocations that Union/Intersection/DifferenceSlice chains incur, and
+// it gives Stop a well-defined, trivial implementation.
+type sliceIterator[T any] struct {
+	items   []T
+	idx     int
+	stopped bool
+}
+
+func (it *sliceIterator[T]) Next() (v T, ok bool) {
+	if it.stopped || it.idx >= len(it.items) {
+		return v, false
+	}
+	v = it.items[it.idx]
+	it.idx++
+	return v, true
+}
+
+func (it *sliceIterator[T]) Stop() {
+	it.stopped = true
+}
+
+// Iter returns an Iterator over the set's elements.
+func (s Set[T]) Iter() Iterator[T] {
+	return &sliceIterator[T]{items: s.List()}
+}
+
+// Iter returns an Iterator over the set's elements.
+func (s Int) Iter() Iterator[int] {
+	return &sliceIterator[int]{items: s.List()}
+}
+
+// Stream is a lazy, single-pass pipeline of set-algebra operations over a
+// Set[T]. Each stage (Union, Intersection, Difference) wraps the previous
+// stage's Next function with a membership test against its operand, rather
+// than materializing an intermediate Set[T]; only the terminal operation
+// (Collect, Slice, Count, AnyMatch, ForEach) actually drives iteration.
+type Stream[T comparable] struct {
+	next func() (T, bool)
+	stop func()
+}
+
+// Stream starts a lazy pipeline over s.
+func (s Set[T]) Stream() *Stream[T] {
+	it := s.Iter()
+	return &Stream[T]{next: it.Next, stop: it.Stop}
+}
+
+// FromIterator starts a lazy pipeline over any Iterator.
+func FromIterator[T comparable](it Iterator[T]) *Stream[T] {
+	return &Stream[T]{next: it.Next, stop: it.Stop}
+}
+
+// Union extends the stream with every element of s2 not already produced by
+// the stream so far. Elements already pulled from the receiver are tracked
+// in a "seen" set so the combined stream yields no duplicates.
+func (st *Stream[T]) Union(s2 Set[T]) *Stream[T] {
+	seen := Set[T]{}
+	upstreamDone := false
+	var s2Iter Iterator[T]
+	next := func() (T, bool) {
+		if !upstreamDone {
+			if v, ok := st.next(); ok {
+				seen.Insert(v)
+				return v, true
+			}
+			upstreamDone = true
+			s2Iter = s2.Iter()
+		}
+		for {
+			v, ok := s2Iter.Next()
+			if !ok {
+				return v, false
+			}
+			if !seen.Contains(v) {
+				return v, true
+			}
+		}
+	}
+	stop := func() {
+		st.stop()
+		if s2Iter != nil {
+			s2Iter.Stop()
+		}
+	}
+	return &Stream[T]{next: next, stop: stop}
+}
+
+// Intersection filters the stream down to elements also present in s2.
+func (st *Stream[T]) Intersection(s2 Set[T]) *Stream[T] {
+	next := func() (T, bool) {
+		for {
+			v, ok := st.next()
+			if !ok {
+				return v, false
+			}
+			if s2.Contains(v) {
+				return v, true
+			}
+		}
+	}
+	return &Stream[T]{next: next, stop: st.stop}
+}
+
+// Difference filters the stream down to elements not present in s2.
+func (st *Stream[T]) Difference(s2 Set[T]) *Stream[T] {
+	next := func() (T, bool) {
+		for {
+			v, ok := st.next()
+			if !ok {
+				return v, false
+			}
+			if !s2.Contains(v) {
+				return v, true
+			}
+		}
+	}
+	return &Stream[T]{next: next, stop: st.stop}
+}
+
+// Collect drains the stream into a Set[T].
+func (st *Stream[T]) Collect() Set[T] {
+	result := Set[T]{}
+	st.ForEach(func(item T) {
+		result.Insert(item)
+	})
+	return result
+}
+
+// Slice drains the stream into a []T, in the order produced.
+func (st *Stream[T]) Slice() []T {
+	var result []T
+	st.ForEach(func(item T) {
+		result = append(result, item)
+	})
+	return result
+}
+
+// Count drains the stream, returning the number of elements produced.
+func (st *Stream[T]) Count() int {
+	n := 0
+	st.ForEach(func(T) {
+		n++
+	})
+	return n
+}
+
+// AnyMatch drains the stream until pred returns true for some element (and
+// stops early), or the stream is exhausted.
+func (st *Stream[T]) AnyMatch(pred func(item T) bool) bool {
+	for {
+		v, ok := st.next()
+		if !ok {
+			return false
+		}
+		if pred(v) {
+			st.stop()
+			return true
+		}
+	}
+}
+
+// ForEach drains the stream, calling f for every element.
+func (st *Stream[T]) ForEach(f func(item T)) {
+	for {
+		v, ok := st.next()
+		if !ok {
+			return
+		}
+		f(v)
+	}
+}
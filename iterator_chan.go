@@ -0,0 +1,72 @@
+package sets
+
+import "sync"
+
+// chanIterator is a channel-backed Iterator[T], mirroring the iterator
+// pattern from deckarep/golang-set: a producer goroutine feeds values over a
+// channel, and Stop closes a done channel the producer selects against, so
+// the goroutine is guaranteed to exit even if the consumer stops pulling
+// before the channel is drained. sliceIterator's Next can return directly
+// from a pre-materialized slice with no goroutine involved; chanIterator
+// exists for callers who want the elements as a <-chan T to plumb into
+// select blocks or pipeline stages.
+type chanIterator[T any] struct {
+	ch   <-chan T
+	done chan struct{}
+	once sync.Once
+}
+
+func newChanIterator[T any](items []T) *chanIterator[T] {
+	ch := make(chan T)
+	done := make(chan struct{})
+	go func() {
+		defer close(ch)
+		for _, item := range items {
+			select {
+			case ch <- item:
+			case <-done:
+				return
+			}
+		}
+	}()
+	return &chanIterator[T]{ch: ch, done: done}
+}
+
+func (it *chanIterator[T]) Next() (T, bool) {
+	v, ok := <-it.ch
+	return v, ok
+}
+
+func (it *chanIterator[T]) Stop() {
+	it.once.Do(func() { close(it.done) })
+}
+
+// ChanIter returns a channel-backed Iterator over the set's elements. Unlike
+// the slice-backed Iterator returned by Iter, this one is driven by a
+// goroutine; call Stop when done early so that goroutine is torn down rather
+// than leaked blocked on a send.
+//
+// There is deliberately no bare "Iter() <-chan T" entry point: a caller
+// holding only a receive-only channel has no way to reach Stop, so breaking
+// out of a range over it before the channel is drained leaks the producer
+// goroutine forever. ChanIter returns the Iterator instead so Stop is always
+// reachable; callers who want a channel to range over can still do
+// "it := s.ChanIter(); defer it.Stop()" and drive it manually, or use the
+// slice-backed Iter for the common no-early-exit case.
+func (s Set[T]) ChanIter() Iterator[T] {
+	return newChanIterator(s.List())
+}
+
+// ChanIter returns a channel-backed Iterator over the set's elements. Unlike
+// the slice-backed Iterator returned by Iter, this one is driven by a
+// goroutine; call Stop when done early so that goroutine is torn down rather
+// than leaked blocked on a send.
+//
+// There is deliberately no bare "Iter() <-chan int32" entry point: a caller
+// holding only a receive-only channel has no way to reach Stop, so breaking
+// out of a range over it before the channel is drained leaks the producer
+// goroutine forever. ChanIter returns the Iterator instead so Stop is always
+// reachable.
+func (s Int32) ChanIter() Iterator[int32] {
+	return newChanIterator(s.List())
+}
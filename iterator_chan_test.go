@@ -0,0 +1,56 @@
+package sets
+
+import "testing"
+
+func TestSetChanIter(t *testing.T) {
+	s := New(1, 2, 3)
+	it := s.ChanIter()
+	count := 0
+	for {
+		v, ok := it.Next()
+		if !ok {
+			break
+		}
+		if !s.Contains(v) {
+			t.Errorf("Unexpected element: %v", v)
+		}
+		count++
+	}
+	if count != 3 {
+		t.Errorf("Expected 3 elements, got %d", count)
+	}
+}
+
+func TestSetChanIter_StopTearsDownGoroutine(t *testing.T) {
+	s := New(1, 2, 3, 4, 5)
+	it := s.ChanIter()
+	if _, ok := it.Next(); !ok {
+		t.Fatalf("Expected at least one element")
+	}
+	it.Stop()
+	// The producer goroutine must not be left blocked sending on ch: once it
+	// exits it closes ch, so draining Next terminates instead of hanging.
+	for {
+		if _, ok := it.Next(); !ok {
+			break
+		}
+	}
+	// A second Stop should also be safe.
+	it.Stop()
+}
+
+func TestInt32_ChanIter(t *testing.T) {
+	s := NewInt32(1, 2, 3)
+	it := s.ChanIter()
+	count := 0
+	for {
+		_, ok := it.Next()
+		if !ok {
+			break
+		}
+		count++
+	}
+	if count != 3 {
+		t.Errorf("Expected 3 elements, got %d", count)
+	}
+}
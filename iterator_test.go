@@ -0,0 +1,67 @@
+package sets
+
+import "testing"
+
+func TestSetIter(t *testing.T) {
+	s := New(1, 2, 3)
+	it := s.Iter()
+	count := 0
+	for {
+		v, ok := it.Next()
+		if !ok {
+			break
+		}
+		if !s.Contains(v) {
+			t.Errorf("Unexpected element: %v", v)
+		}
+		count++
+	}
+	if count != 3 {
+		t.Errorf("Expected 3 elements, got %d", count)
+	}
+}
+
+func TestIntIter(t *testing.T) {
+	s := NewInt(1, 2, 3)
+	it := s.Iter()
+	count := 0
+	for {
+		_, ok := it.Next()
+		if !ok {
+			break
+		}
+		count++
+	}
+	if count != 3 {
+		t.Errorf("Expected 3 elements, got %d", count)
+	}
+}
+
+func TestStream_Chain(t *testing.T) {
+	a := New(1, 2, 3, 4, 5)
+	b := New(3, 4, 5, 6)
+	c := New(6, 7)
+
+	got := a.Stream().Union(c).Intersection(b.Union(c)).Collect()
+	want := New(3, 4, 5, 6, 7)
+	if !got.Equal(want) {
+		t.Errorf("Expected %v, got %v", want.List(), got.List())
+	}
+}
+
+func TestStream_Terminals(t *testing.T) {
+	a := New(1, 2, 3, 4)
+	b := New(2, 4)
+
+	if got := a.Stream().Difference(b).Count(); got != 2 {
+		t.Errorf("Expected count=2, got %d", got)
+	}
+	if !a.Stream().Intersection(b).AnyMatch(func(item int) bool { return item == 2 }) {
+		t.Errorf("Expected AnyMatch to find 2")
+	}
+	sum := 0
+	a.Stream().ForEach(func(item int) { sum += item })
+	if sum != 10 {
+		t.Errorf("Expected sum=10, got %d", sum)
+	}
+}
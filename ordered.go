@@ -0,0 +1,257 @@
+package sets
+
+// OrderedSet is a set of `T` that preserves insertion order for iteration,
+// unlike Set[T] which iterates in random map order. It is backed by a
+// map[T]int recording each element's position in an ordered slice, plus the
+// slice itself. Deleted slots are tombstoned (left as zero values) rather
+// than compacted on every call; the slice is rebuilt once the fraction of
+// tombstones crosses orderedRebuildThreshold.
+type OrderedSet[T comparable] struct {
+	index map[T]int
+	items []T
+	dead  int
+}
+
+// orderedRebuildThreshold is the fraction of tombstoned slots that triggers
+// a compaction of the backing slice.
+const orderedRebuildThreshold = 0.5
+
+// NewOrdered creates an OrderedSet from a list of values, in the order given.
+func NewOrdered[T comparable](items ...T) *OrderedSet[T] {
+	s := &OrderedSet[T]{index: make(map[T]int, len(items))}
+	s.Insert(items...)
+	return s
+}
+
+// Insert adds items to the set, appending any not already present in
+// insertion order.
+func (s *OrderedSet[T]) Insert(items ...T) *OrderedSet[T] {
+	for _, item := range items {
+		if _, ok := s.index[item]; ok {
+			continue
+		}
+		s.index[item] = len(s.items)
+		s.items = append(s.items, item)
+	}
+	return s
+}
+
+// Delete removes all items from the set.
+func (s *OrderedSet[T]) Delete(items ...T) *OrderedSet[T] {
+	for _, item := range items {
+		i, ok := s.index[item]
+		if !ok {
+			continue
+		}
+		delete(s.index, item)
+		var zero T
+		s.items[i] = zero
+		s.dead++
+	}
+	if s.dead > 0 && float64(s.dead) >= orderedRebuildThreshold*float64(len(s.items)) {
+		s.rebuild()
+	}
+	return s
+}
+
+// rebuild compacts the backing slice, dropping tombstones and rewriting
+// indices.
+func (s *OrderedSet[T]) rebuild() {
+	items := make([]T, 0, len(s.index))
+	for i, item := range s.items {
+		if idx, ok := s.index[item]; !ok || idx != i {
+			continue
+		}
+		items = append(items, item)
+	}
+	s.items = items
+	for i, item := range s.items {
+		s.index[item] = i
+	}
+	s.dead = 0
+}
+
+// Contains returns true if and only if item is contained in the set.
+func (s *OrderedSet[T]) Contains(item T) bool {
+	_, ok := s.index[item]
+	return ok
+}
+
+// ContainsAll returns true if and only if all items are contained in the set.
+func (s *OrderedSet[T]) ContainsAll(items ...T) bool {
+	for _, item := range items {
+		if !s.Contains(item) {
+			return false
+		}
+	}
+	return true
+}
+
+// ContainsAny returns true if any items are contained in the set.
+func (s *OrderedSet[T]) ContainsAny(items ...T) bool {
+	for _, item := range items {
+		if s.Contains(item) {
+			return true
+		}
+	}
+	return false
+}
+
+// Len returns the size of the set.
+func (s *OrderedSet[T]) Len() int {
+	return len(s.index)
+}
+
+// List returns the contents in insertion order.
+func (s *OrderedSet[T]) List() []T {
+	res := make([]T, 0, len(s.index))
+	s.Each(func(item T) bool {
+		res = append(res, item)
+		return true
+	})
+	return res
+}
+
+// Each traverses the items in insertion order, calling the provided function
+// for each set member. Traversal will continue until all items in the set
+// have been visited, or if the closure returns false.
+//
+// A tombstoned slot is checked by position, not just by the value left
+// behind in it: a deleted slot is zeroed in place (see Delete), and if that
+// zero value happens to equal a genuinely live element elsewhere in the
+// slice, keying solely on s.index[item] would yield that live element twice.
+func (s *OrderedSet[T]) Each(f func(item T) bool) {
+	for i, item := range s.items {
+		if idx, ok := s.index[item]; !ok || idx != i {
+			continue
+		}
+		if !f(item) {
+			return
+		}
+	}
+}
+
+// At returns the i'th element in insertion order. It panics if i is out of
+// range, mirroring slice indexing. If the element at i has been deleted and
+// not yet compacted away by a rebuild, At returns the zero value of T; use
+// IndexOf/Contains to check liveness.
+func (s *OrderedSet[T]) At(i int) T {
+	return s.items[i]
+}
+
+// IndexOf returns the insertion-order position of item, and whether it is
+// present in the set.
+func (s *OrderedSet[T]) IndexOf(item T) (int, bool) {
+	i, ok := s.index[item]
+	return i, ok
+}
+
+// Index returns the insertion-order position of item, or -1 if item is not
+// present. It is a single-return wrapper around IndexOf for callers used to
+// the strings.Index-style signature.
+func (s *OrderedSet[T]) Index(item T) int {
+	if i, ok := s.IndexOf(item); ok {
+		return i
+	}
+	return -1
+}
+
+// Pop returns the oldest inserted element still present in the set (FIFO).
+func (s *OrderedSet[T]) Pop() (v T, ok bool) {
+	for i, item := range s.items {
+		if idx, ok := s.index[item]; ok && idx == i {
+			s.Delete(item)
+			return item, true
+		}
+	}
+	return
+}
+
+// Clone returns a new OrderedSet with a copy of s, preserving order.
+func (s *OrderedSet[T]) Clone() *OrderedSet[T] {
+	return NewOrdered(s.List()...)
+}
+
+// Union returns a new OrderedSet with the receiver's order followed by any
+// new elements from s2 in s2's order.
+func (s *OrderedSet[T]) Union(s2 *OrderedSet[T]) *OrderedSet[T] {
+	result := s.Clone()
+	result.Insert(s2.List()...)
+	return result
+}
+
+// Intersection returns a new OrderedSet, in the receiver's order, which
+// includes the items in BOTH s1 and s2.
+func (s *OrderedSet[T]) Intersection(s2 *OrderedSet[T]) *OrderedSet[T] {
+	result := NewOrdered[T]()
+	s.Each(func(item T) bool {
+		if s2.Contains(item) {
+			result.Insert(item)
+		}
+		return true
+	})
+	return result
+}
+
+// Difference returns a new OrderedSet, in the receiver's order, with objects
+// that are not in s2.
+func (s *OrderedSet[T]) Difference(s2 *OrderedSet[T]) *OrderedSet[T] {
+	result := NewOrdered[T]()
+	s.Each(func(item T) bool {
+		if !s2.Contains(item) {
+			result.Insert(item)
+		}
+		return true
+	})
+	return result
+}
+
+// Equal returns true if and only if s1 is equal (as a set) to s2. Two
+// OrderedSets are equal if their membership is identical; insertion order is
+// not considered.
+func (s *OrderedSet[T]) Equal(s2 *OrderedSet[T]) bool {
+	if s.Len() != s2.Len() {
+		return false
+	}
+	return s.ContainsAll(s2.List()...)
+}
+
+// Diff returns s diff of s2, return added, removed, remained sets, each in
+// the order the elements were first seen (receiver order for removed and
+// remained, s2's order for added).
+// For example:
+// s1 = {a1, a3, a5, a7}
+// s2 = {a3, a4, a5, a6}
+// added = {a4, a6}
+// removed = {a1, a7}
+// remained = {a3, a5}
+func (s *OrderedSet[T]) Diff(s2 *OrderedSet[T]) (added, removed, remained *OrderedSet[T]) {
+	added, removed, remained = NewOrdered[T](), NewOrdered[T](), NewOrdered[T]()
+	s.Each(func(item T) bool {
+		if s2.Contains(item) {
+			remained.Insert(item)
+		} else {
+			removed.Insert(item)
+		}
+		return true
+	})
+	s2.Each(func(item T) bool {
+		if !s.Contains(item) {
+			added.Insert(item)
+		}
+		return true
+	})
+	return added, removed, remained
+}
+
+// DiffVary returns s diff of s2, return added, removed sets, each in the
+// order the elements were first seen.
+// For example:
+// s1 = {a1, a3, a5, a7}
+// s2 = {a3, a4, a5, a6}
+// added = {a4, a6}
+// removed = {a1, a7}
+func (s *OrderedSet[T]) DiffVary(s2 *OrderedSet[T]) (added, removed *OrderedSet[T]) {
+	added, removed, _ = s.Diff(s2)
+	return added, removed
+}
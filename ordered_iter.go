@@ -0,0 +1,28 @@
+//go:build go1.23
+
+package sets
+
+import "iter"
+
+// Values returns an iterator over s's elements in insertion order.
+func (s *OrderedSet[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		s.Each(func(item T) bool {
+			return yield(item)
+		})
+	}
+}
+
+// All returns an iterator over s's elements in insertion order, paired with
+// their position counted from 0 over live elements only. While deletions
+// await compaction this can differ from the position At/IndexOf use.
+func (s *OrderedSet[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		s.Each(func(item T) bool {
+			ok := yield(i, item)
+			i++
+			return ok
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package sets
+
+import "testing"
+
+func TestOrderedSet_InsertionOrder(t *testing.T) {
+	s := NewOrdered(3, 1, 2, 1)
+	want := []int{3, 1, 2}
+	got := s.List()
+	if len(got) != len(want) {
+		t.Fatalf("Expected %v, got %v", want, got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Expected %v, got %v", want, got)
+		}
+	}
+}
+
+func TestOrderedSet_DeleteAndRebuild(t *testing.T) {
+	s := NewOrdered(1, 2, 3, 4)
+	s.Delete(2, 3)
+	if s.Len() != 2 {
+		t.Errorf("Expected len=2: %d", s.Len())
+	}
+	if s.Contains(2) || s.Contains(3) {
+		t.Errorf("Unexpected contents: %#v", s.List())
+	}
+	want := []int{1, 4}
+	got := s.List()
+	if len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+}
+
+func TestOrderedSet_DeleteZeroValueSentinelNotAliased(t *testing.T) {
+	s := NewOrdered(0, 1, 2)
+	s.Delete(1)
+	if s.Len() != 2 {
+		t.Errorf("Expected len=2, got %d", s.Len())
+	}
+	want := []int{0, 2}
+	got := s.List()
+	if len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+		t.Errorf("Expected %v, got %v (tombstone aliased with live zero value)", want, got)
+	}
+}
+
+func TestOrderedSet_Pop(t *testing.T) {
+	s := NewOrdered(1, 2, 3)
+	v, ok := s.Pop()
+	if !ok || v != 1 {
+		t.Errorf("Expected FIFO pop of 1, got %v, %v", v, ok)
+	}
+}
+
+func TestOrderedSet_SetOps(t *testing.T) {
+	a := NewOrdered(1, 2, 3)
+	b := NewOrdered(2, 3, 4)
+
+	union := a.Union(b)
+	if !union.Equal(NewOrdered(1, 2, 3, 4)) {
+		t.Errorf("Unexpected union: %v", union.List())
+	}
+	if got, want := union.List(), []int{1, 2, 3, 4}; !equalIntSlice(got, want) {
+		t.Errorf("Expected union order %v, got %v", want, got)
+	}
+
+	inter := a.Intersection(b)
+	if !inter.Equal(NewOrdered(2, 3)) {
+		t.Errorf("Unexpected intersection: %v", inter.List())
+	}
+
+	diff := a.Difference(b)
+	if !diff.Equal(NewOrdered(1)) {
+		t.Errorf("Unexpected difference: %v", diff.List())
+	}
+}
+
+func TestOrderedSet_AtAndIndexOf(t *testing.T) {
+	s := NewOrdered(10, 20, 30)
+	if s.At(1) != 20 {
+		t.Errorf("Expected At(1)=20, got %v", s.At(1))
+	}
+	if i, ok := s.IndexOf(30); !ok || i != 2 {
+		t.Errorf("Expected IndexOf(30)=2, got %v, %v", i, ok)
+	}
+	if _, ok := s.IndexOf(40); ok {
+		t.Errorf("Expected IndexOf(40) to report not found")
+	}
+	if s.Index(30) != 2 {
+		t.Errorf("Expected Index(30)=2, got %v", s.Index(30))
+	}
+	if s.Index(40) != -1 {
+		t.Errorf("Expected Index(40)=-1, got %v", s.Index(40))
+	}
+}
+
+func TestOrderedSet_Diff(t *testing.T) {
+	a := NewOrdered(1, 3, 5, 7)
+	b := NewOrdered(3, 4, 5, 6)
+
+	added, removed, remained := a.Diff(b)
+	if !added.Equal(NewOrdered(4, 6)) {
+		t.Errorf("Unexpected added: %v", added.List())
+	}
+	if !removed.Equal(NewOrdered(1, 7)) {
+		t.Errorf("Unexpected removed: %v", removed.List())
+	}
+	if !remained.Equal(NewOrdered(3, 5)) {
+		t.Errorf("Unexpected remained: %v", remained.List())
+	}
+
+	added2, removed2 := a.DiffVary(b)
+	if !added2.Equal(added) || !removed2.Equal(removed) {
+		t.Errorf("DiffVary should match Diff's added/removed")
+	}
+}
+
+func equalIntSlice(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
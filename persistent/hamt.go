@@ -0,0 +1,227 @@
+package persistent
+
+import "math/bits"
+
+// bitsPerLevel is the number of hash bits consumed at each trie level,
+// giving each bitmapNode up to 32 children.
+const bitsPerLevel = 5
+
+const levelMask = 1<<bitsPerLevel - 1
+
+// node is either a *bitmapNode (an interior trie node) or a *valuesNode (a
+// bucket of every element sharing one hash value).
+type node[T comparable] interface {
+	isNode()
+}
+
+// bitmapNode is an interior node of the trie. bitmap has one bit set for
+// every populated child slot; children holds only the populated slots, in
+// ascending bit order, so a node with k children out of 32 possible slots
+// allocates exactly k pointers instead of 32.
+type bitmapNode[T comparable] struct {
+	bitmap   uint32
+	children []node[T]
+}
+
+func (*bitmapNode[T]) isNode() {}
+
+// valuesNode holds every element that shares hash, once the trie has
+// consumed enough bits to route them to the same slot. len(values) is 1 in
+// the overwhelming common case; it is greater than 1 only on a genuine
+// Hasher collision between distinct elements.
+type valuesNode[T comparable] struct {
+	hash   uint64
+	values []T
+}
+
+func (*valuesNode[T]) isNode() {}
+
+func childIndex(hash uint64, shift uint) uint32 {
+	return uint32(hash>>shift) & levelMask
+}
+
+func bitpos(index uint32) uint32 {
+	return 1 << index
+}
+
+// slotOf returns the position within children that bit occupies, whether or
+// not it is currently set.
+func slotOf(bitmap, bit uint32) int {
+	return bits.OnesCount32(bitmap & (bit - 1))
+}
+
+func withChildInserted[T comparable](children []node[T], pos int, child node[T]) []node[T] {
+	out := make([]node[T], len(children)+1)
+	copy(out, children[:pos])
+	out[pos] = child
+	copy(out[pos+1:], children[pos:])
+	return out
+}
+
+func withChildReplaced[T comparable](children []node[T], pos int, child node[T]) []node[T] {
+	out := make([]node[T], len(children))
+	copy(out, children)
+	out[pos] = child
+	return out
+}
+
+func withChildRemoved[T comparable](children []node[T], pos int) []node[T] {
+	out := make([]node[T], len(children)-1)
+	copy(out, children[:pos])
+	copy(out[pos:], children[pos+1:])
+	return out
+}
+
+// indexOfValue returns the position of v within values, using ==, or -1.
+func indexOfValue[T comparable](values []T, v T) int {
+	for i, existing := range values {
+		if existing == v {
+			return i
+		}
+	}
+	return -1
+}
+
+// add returns the trie rooted at n with value (hashing to hash) present,
+// sharing every subtree that insertion didn't touch, and whether the value
+// was newly added.
+func add[T comparable](n node[T], hash uint64, value T, shift uint) (node[T], bool) {
+	if n == nil {
+		return &valuesNode[T]{hash: hash, values: []T{value}}, true
+	}
+	switch cur := n.(type) {
+	case *valuesNode[T]:
+		if cur.hash != hash {
+			return splitValuesNode(cur, &valuesNode[T]{hash: hash, values: []T{value}}, shift), true
+		}
+		if indexOfValue(cur.values, value) >= 0 {
+			return cur, false
+		}
+		values := make([]T, len(cur.values)+1)
+		copy(values, cur.values)
+		values[len(cur.values)] = value
+		return &valuesNode[T]{hash: hash, values: values}, true
+	case *bitmapNode[T]:
+		idx := childIndex(hash, shift)
+		bit := bitpos(idx)
+		pos := slotOf(cur.bitmap, bit)
+		if cur.bitmap&bit == 0 {
+			child := node[T](&valuesNode[T]{hash: hash, values: []T{value}})
+			return &bitmapNode[T]{bitmap: cur.bitmap | bit, children: withChildInserted(cur.children, pos, child)}, true
+		}
+		child, added := add(cur.children[pos], hash, value, shift+bitsPerLevel)
+		if !added {
+			return cur, false
+		}
+		return &bitmapNode[T]{bitmap: cur.bitmap, children: withChildReplaced(cur.children, pos, child)}, true
+	default:
+		panic("sets/persistent: unreachable node type")
+	}
+}
+
+// splitValuesNode builds the smallest bitmapNode chain that routes a and b
+// (which have different hashes) to separate slots.
+func splitValuesNode[T comparable](a, b *valuesNode[T], shift uint) node[T] {
+	ia, ib := childIndex(a.hash, shift), childIndex(b.hash, shift)
+	if ia == ib {
+		return &bitmapNode[T]{bitmap: bitpos(ia), children: []node[T]{splitValuesNode(a, b, shift+bitsPerLevel)}}
+	}
+	bitmap := bitpos(ia) | bitpos(ib)
+	children := make([]node[T], 2)
+	if ia < ib {
+		children[0], children[1] = a, b
+	} else {
+		children[0], children[1] = b, a
+	}
+	return &bitmapNode[T]{bitmap: bitmap, children: children}
+}
+
+// remove returns the trie rooted at n with value (hashing to hash) absent,
+// sharing every subtree removal didn't touch, and whether it was present.
+func remove[T comparable](n node[T], hash uint64, value T, shift uint) (node[T], bool) {
+	if n == nil {
+		return nil, false
+	}
+	switch cur := n.(type) {
+	case *valuesNode[T]:
+		if cur.hash != hash {
+			return cur, false
+		}
+		i := indexOfValue(cur.values, value)
+		if i < 0 {
+			return cur, false
+		}
+		if len(cur.values) == 1 {
+			return nil, true
+		}
+		values := make([]T, 0, len(cur.values)-1)
+		values = append(values, cur.values[:i]...)
+		values = append(values, cur.values[i+1:]...)
+		return &valuesNode[T]{hash: hash, values: values}, true
+	case *bitmapNode[T]:
+		idx := childIndex(hash, shift)
+		bit := bitpos(idx)
+		if cur.bitmap&bit == 0 {
+			return cur, false
+		}
+		pos := slotOf(cur.bitmap, bit)
+		child, removed := remove(cur.children[pos], hash, value, shift+bitsPerLevel)
+		if !removed {
+			return cur, false
+		}
+		if child == nil {
+			if len(cur.children) == 1 {
+				return nil, true
+			}
+			return &bitmapNode[T]{bitmap: cur.bitmap &^ bit, children: withChildRemoved(cur.children, pos)}, true
+		}
+		return &bitmapNode[T]{bitmap: cur.bitmap, children: withChildReplaced(cur.children, pos, child)}, true
+	default:
+		panic("sets/persistent: unreachable node type")
+	}
+}
+
+func contains[T comparable](n node[T], hash uint64, value T, shift uint) bool {
+	for n != nil {
+		switch cur := n.(type) {
+		case *valuesNode[T]:
+			return cur.hash == hash && indexOfValue(cur.values, value) >= 0
+		case *bitmapNode[T]:
+			bit := bitpos(childIndex(hash, shift))
+			if cur.bitmap&bit == 0 {
+				return false
+			}
+			n = cur.children[slotOf(cur.bitmap, bit)]
+			shift += bitsPerLevel
+		default:
+			panic("sets/persistent: unreachable node type")
+		}
+	}
+	return false
+}
+
+// rangeNode walks every element reachable from n, calling f for each. It
+// stops and returns false as soon as f returns false.
+func rangeNode[T comparable](n node[T], f func(T) bool) bool {
+	if n == nil {
+		return true
+	}
+	switch cur := n.(type) {
+	case *valuesNode[T]:
+		for _, v := range cur.values {
+			if !f(v) {
+				return false
+			}
+		}
+		return true
+	case *bitmapNode[T]:
+		for _, child := range cur.children {
+			if !rangeNode(child, f) {
+				return false
+			}
+		}
+		return true
+	default:
+		panic("sets/persistent: unreachable node type")
+	}
+}
@@ -0,0 +1,129 @@
+package persistent
+
+import "math/bits"
+
+// Resolution tells a Resolver which of two colliding elements should
+// survive a merge.
+type Resolution int
+
+const (
+	// TakeLhs keeps the element from the receiver (the Set a merge method
+	// is called on).
+	TakeLhs Resolution = iota
+	// TakeRhs keeps the element from the argument Set.
+	TakeRhs
+)
+
+// Resolver collapses a genuine Hasher collision -- two elements that hash
+// identically but are not equal by == -- down to a single survivor during a
+// merge. When resolver is nil (the default passed by Union/Intersection/
+// Difference), both colliding elements are kept, which is the only
+// behavior consistent with ordinary set semantics; supplying a Resolver is
+// an explicit opt-in to treat same-hash elements as interchangeable. If a
+// hash already collects more than two elements, resolver is only asked to
+// arbitrate against the first element stored at that hash.
+type Resolver[T comparable] func(lhs, rhs T) Resolution
+
+func resolve[T comparable](lhs, rhs T, resolver Resolver[T]) T {
+	if resolver != nil && resolver(lhs, rhs) == TakeRhs {
+		return rhs
+	}
+	return lhs
+}
+
+// mergeValuesNodes combines two nodes known to share the same hash.
+func mergeValuesNodes[T comparable](lhs, rhs *valuesNode[T], resolver Resolver[T]) *valuesNode[T] {
+	values := make([]T, len(lhs.values))
+	copy(values, lhs.values)
+	for _, v := range rhs.values {
+		if indexOfValue(values, v) >= 0 {
+			continue
+		}
+		if resolver == nil {
+			values = append(values, v)
+			continue
+		}
+		values[0] = resolve(values[0], v, resolver)
+	}
+	return &valuesNode[T]{hash: lhs.hash, values: values}
+}
+
+// mergeUnion returns the trie containing every element of lhs or rhs,
+// reusing every subtree that exists on only one side untouched.
+func mergeUnion[T comparable](lhs, rhs node[T], shift uint, resolver Resolver[T]) node[T] {
+	if lhs == nil {
+		return rhs
+	}
+	if rhs == nil {
+		return lhs
+	}
+	if lv, ok := lhs.(*valuesNode[T]); ok {
+		if rv, ok := rhs.(*valuesNode[T]); ok {
+			if lv.hash != rv.hash {
+				return splitValuesNode(lv, rv, shift)
+			}
+			return mergeValuesNodes(lv, rv, resolver)
+		}
+		return insertValuesNode(rhs, shift, lv, true, resolver)
+	}
+	if rv, ok := rhs.(*valuesNode[T]); ok {
+		return insertValuesNode(lhs, shift, rv, false, resolver)
+	}
+
+	ln, rn := lhs.(*bitmapNode[T]), rhs.(*bitmapNode[T])
+	bitmap := ln.bitmap | rn.bitmap
+	children := make([]node[T], bits.OnesCount32(bitmap))
+	li, ri, pos := 0, 0, 0
+	for bm := bitmap; bm != 0; bm &= bm - 1 {
+		bit := bm & -bm
+		hasL, hasR := ln.bitmap&bit != 0, rn.bitmap&bit != 0
+		switch {
+		case hasL && hasR:
+			children[pos] = mergeUnion(ln.children[li], rn.children[ri], shift+bitsPerLevel, resolver)
+			li++
+			ri++
+		case hasL:
+			children[pos] = ln.children[li]
+			li++
+		default:
+			children[pos] = rn.children[ri]
+			ri++
+		}
+		pos++
+	}
+	return &bitmapNode[T]{bitmap: bitmap, children: children}
+}
+
+// insertValuesNode pushes vn (a bucket holding however many elements share
+// one hash) down into into, which belongs to the other side of the merge
+// from vn. vnIsLhs says which side vn came from, so that a same-hash
+// collision calls resolver with (lhs, rhs) in the caller's original order.
+func insertValuesNode[T comparable](into node[T], shift uint, vn *valuesNode[T], vnIsLhs bool, resolver Resolver[T]) node[T] {
+	if into == nil {
+		return vn
+	}
+	switch cur := into.(type) {
+	case *valuesNode[T]:
+		if cur.hash != vn.hash {
+			if vnIsLhs {
+				return splitValuesNode(vn, cur, shift)
+			}
+			return splitValuesNode(cur, vn, shift)
+		}
+		if vnIsLhs {
+			return mergeValuesNodes(vn, cur, resolver)
+		}
+		return mergeValuesNodes(cur, vn, resolver)
+	case *bitmapNode[T]:
+		idx := childIndex(vn.hash, shift)
+		bit := bitpos(idx)
+		pos := slotOf(cur.bitmap, bit)
+		if cur.bitmap&bit == 0 {
+			return &bitmapNode[T]{bitmap: cur.bitmap | bit, children: withChildInserted(cur.children, pos, node[T](vn))}
+		}
+		child := insertValuesNode(cur.children[pos], shift+bitsPerLevel, vn, vnIsLhs, resolver)
+		return &bitmapNode[T]{bitmap: cur.bitmap, children: withChildReplaced(cur.children, pos, child)}
+	default:
+		panic("sets/persistent: unreachable node type")
+	}
+}
@@ -0,0 +1,158 @@
+// Package persistent provides an immutable, persistent Set backed by a hash
+// array mapped trie (HAMT) with structural sharing, similar in spirit to
+// Clojure's or Scala's persistent collections. Unlike sets.Set, every
+// mutating operation returns a new Set instead of modifying the receiver,
+// and unaffected subtrees are shared between the old and new versions
+// rather than copied, so callers can cheaply keep historical snapshots
+// (e.g. for a diff engine) without paying sets.Set's O(n) Clone cost.
+package persistent
+
+// Hasher computes a stable 64-bit hash for values of type T, used to place
+// elements within a Set's trie. It plays the same role for Set that
+// sets.Comparator plays for sets.TreeSet: the caller supplies it explicitly
+// rather than the package attempting to derive one via reflection.
+type Hasher[T any] interface {
+	Hash(v T) uint64
+}
+
+// HasherFunc adapts a plain function to a Hasher.
+type HasherFunc[T any] func(v T) uint64
+
+// Hash implements Hasher.
+func (f HasherFunc[T]) Hash(v T) uint64 { return f(v) }
+
+// Set is an immutable set of T. Every method that would mutate a mutable
+// set instead returns a new Set; the receiver is never modified.
+type Set[T comparable] struct {
+	root   node[T]
+	size   int
+	hasher Hasher[T]
+}
+
+// New creates a Set containing items, hashed with hasher.
+func New[T comparable](hasher Hasher[T], items ...T) *Set[T] {
+	s := &Set[T]{hasher: hasher}
+	for _, item := range items {
+		s = s.Add(item)
+	}
+	return s
+}
+
+// Add returns a new Set containing every element of s plus value, sharing
+// every subtree Add didn't need to touch.
+func (s *Set[T]) Add(value T) *Set[T] {
+	root, added := add(s.root, s.hasher.Hash(value), value, 0)
+	if !added {
+		return s
+	}
+	return &Set[T]{root: root, size: s.size + 1, hasher: s.hasher}
+}
+
+// Remove returns a new Set containing every element of s except value,
+// sharing every subtree Remove didn't need to touch.
+func (s *Set[T]) Remove(value T) *Set[T] {
+	root, removed := remove(s.root, s.hasher.Hash(value), value, 0)
+	if !removed {
+		return s
+	}
+	return &Set[T]{root: root, size: s.size - 1, hasher: s.hasher}
+}
+
+// Contains returns true if and only if value is contained in the set.
+func (s *Set[T]) Contains(value T) bool {
+	return contains(s.root, s.hasher.Hash(value), value, 0)
+}
+
+// Len returns the number of elements in the set.
+func (s *Set[T]) Len() int {
+	return s.size
+}
+
+// Range calls f for every element of the set, in unspecified order,
+// stopping early if f returns false.
+func (s *Set[T]) Range(f func(value T) bool) {
+	rangeNode(s.root, f)
+}
+
+// List returns the contents of the set as a slice, in unspecified order.
+func (s *Set[T]) List() []T {
+	res := make([]T, 0, s.size)
+	s.Range(func(v T) bool {
+		res = append(res, v)
+		return true
+	})
+	return res
+}
+
+// Union returns a new Set containing every element of s or s2, sharing
+// every subtree that exists on only one side. Elements that collide (same
+// Hasher output, not equal by ==) on both sides are both kept.
+func (s *Set[T]) Union(s2 *Set[T]) *Set[T] {
+	return s.UnionResolve(s2, nil)
+}
+
+// UnionResolve is Union, but resolver decides which element survives when
+// s and s2 hold different elements that collide on hash. See Resolver.
+func (s *Set[T]) UnionResolve(s2 *Set[T], resolver Resolver[T]) *Set[T] {
+	root := mergeUnion(s.root, s2.root, 0, resolver)
+	return &Set[T]{root: root, size: countNode[T](root), hasher: s.hasher}
+}
+
+// Intersection returns a new Set containing the elements present in both s
+// and s2. Unlike Union it does not attempt deep structural sharing: the
+// result's membership differs from both operands throughout, so it is
+// rebuilt by walking the smaller operand and testing the other, the same
+// O(n) shape as sets.Set.Intersection.
+func (s *Set[T]) Intersection(s2 *Set[T]) *Set[T] {
+	small, big := s, s2
+	if small.size > big.size {
+		small, big = big, small
+	}
+	result := &Set[T]{hasher: s.hasher}
+	small.Range(func(v T) bool {
+		if big.Contains(v) {
+			result = result.Add(v)
+		}
+		return true
+	})
+	return result
+}
+
+// Difference returns a new Set containing the elements of s that are not
+// present in s2. Like Intersection, it does not attempt deep structural
+// sharing with either operand.
+func (s *Set[T]) Difference(s2 *Set[T]) *Set[T] {
+	result := &Set[T]{hasher: s.hasher}
+	s.Range(func(v T) bool {
+		if !s2.Contains(v) {
+			result = result.Add(v)
+		}
+		return true
+	})
+	return result
+}
+
+// Equal returns true if and only if s is equal (as a set) to s2.
+func (s *Set[T]) Equal(s2 *Set[T]) bool {
+	if s.Len() != s2.Len() {
+		return false
+	}
+	equal := true
+	s.Range(func(v T) bool {
+		if !s2.Contains(v) {
+			equal = false
+			return false
+		}
+		return true
+	})
+	return equal
+}
+
+func countNode[T comparable](n node[T]) int {
+	count := 0
+	rangeNode(n, func(T) bool {
+		count++
+		return true
+	})
+	return count
+}
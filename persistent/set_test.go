@@ -0,0 +1,130 @@
+package persistent
+
+import "testing"
+
+type intHasher struct{}
+
+func (intHasher) Hash(v int) uint64 { return uint64(v) }
+
+func newIntSet(items ...int) *Set[int] {
+	return New[int](intHasher{}, items...)
+}
+
+func TestSet_AddContainsRemove(t *testing.T) {
+	s := newIntSet(1, 2, 3)
+	if s.Len() != 3 {
+		t.Errorf("Expected len=3: %d", s.Len())
+	}
+	if !s.Contains(2) {
+		t.Errorf("Missing contents: %v", s.List())
+	}
+	s2 := s.Remove(2)
+	if s2.Contains(2) {
+		t.Errorf("Unexpected contents: %v", s2.List())
+	}
+	if !s.Contains(2) {
+		t.Errorf("Remove must not mutate the receiver")
+	}
+}
+
+func TestSet_AddIsImmutable(t *testing.T) {
+	s := newIntSet(1)
+	s2 := s.Add(2)
+	if s.Contains(2) {
+		t.Errorf("Add must not mutate the receiver")
+	}
+	if !s2.Contains(1) || !s2.Contains(2) {
+		t.Errorf("Missing contents: %v", s2.List())
+	}
+}
+
+func TestSet_SetOps(t *testing.T) {
+	a := newIntSet(1, 2, 3)
+	b := newIntSet(2, 3, 4)
+	if !a.Union(b).Equal(newIntSet(1, 2, 3, 4)) {
+		t.Errorf("Unexpected union: %v", a.Union(b).List())
+	}
+	if !a.Intersection(b).Equal(newIntSet(2, 3)) {
+		t.Errorf("Unexpected intersection: %v", a.Intersection(b).List())
+	}
+	if !a.Difference(b).Equal(newIntSet(1)) {
+		t.Errorf("Unexpected difference: %v", a.Difference(b).List())
+	}
+}
+
+func TestSet_ManyElements(t *testing.T) {
+	var s *Set[int]
+	s = newIntSet()
+	const n = 5000
+	for i := 0; i < n; i++ {
+		s = s.Add(i)
+	}
+	if s.Len() != n {
+		t.Fatalf("Expected len=%d: %d", n, s.Len())
+	}
+	for i := 0; i < n; i++ {
+		if !s.Contains(i) {
+			t.Fatalf("Missing %d", i)
+		}
+	}
+	for i := 0; i < n; i += 2 {
+		s = s.Remove(i)
+	}
+	if s.Len() != n/2 {
+		t.Fatalf("Expected len=%d after removal: %d", n/2, s.Len())
+	}
+}
+
+// collidingHasher maps every value onto one of a handful of buckets, so
+// insertions are guaranteed to exercise the collision path.
+type collidingHasher struct{}
+
+func (collidingHasher) Hash(v int) uint64 { return uint64(v % 4) }
+
+func TestSet_HashCollision(t *testing.T) {
+	s := New[int](collidingHasher{}, 1, 5, 9, 2, 6)
+	if s.Len() != 5 {
+		t.Fatalf("Expected len=5: %d", s.Len())
+	}
+	for _, v := range []int{1, 5, 9, 2, 6} {
+		if !s.Contains(v) {
+			t.Errorf("Missing %d", v)
+		}
+	}
+	s2 := s.Remove(5)
+	if s2.Contains(5) || !s2.Contains(1) || !s2.Contains(9) {
+		t.Errorf("Unexpected contents after removal: %v", s2.List())
+	}
+}
+
+func TestSet_UnionResolve(t *testing.T) {
+	a := New[int](collidingHasher{}, 1)
+	b := New[int](collidingHasher{}, 5)
+
+	both := a.Union(b)
+	if !both.Contains(1) || !both.Contains(5) {
+		t.Errorf("Default Union should keep both colliding elements: %v", both.List())
+	}
+
+	lhs := a.UnionResolve(b, func(lhs, rhs int) Resolution { return TakeLhs })
+	if !lhs.Contains(1) || lhs.Contains(5) {
+		t.Errorf("UnionResolve(TakeLhs) should drop rhs: %v", lhs.List())
+	}
+
+	rhs := a.UnionResolve(b, func(lhs, rhs int) Resolution { return TakeRhs })
+	if !rhs.Contains(5) || rhs.Contains(1) {
+		t.Errorf("UnionResolve(TakeRhs) should drop lhs: %v", rhs.List())
+	}
+}
+
+func TestSet_Range_EarlyStop(t *testing.T) {
+	s := newIntSet(1, 2, 3, 4, 5)
+	count := 0
+	s.Range(func(int) bool {
+		count++
+		return count < 2
+	})
+	if count != 2 {
+		t.Errorf("Expected range to stop after 2 calls, got %d", count)
+	}
+}
@@ -1,5 +1,7 @@
 package sets
 
+import "sort"
+
 // Difference returns a set of objects that are not in s2
 // For example:
 // s1 = {a1, a2, a3}
@@ -107,6 +109,177 @@ func (s Set[T]) DiffVary(s2 Set[T]) (added, removed Set[T]) {
 	return added, removed
 }
 
+// SymmetricDifference returns a set of objects that are in s or s2, but not in both.
+// For example:
+// s1 = {a1, a2, a3}
+// s2 = {a1, a2, a4, a5}
+// s1.SymmetricDifference(s2) = {a3, a4, a5}.
+func (s Set[T]) SymmetricDifference(s2 Set[T]) Set[T] {
+	result := newSet[T](len(s) + len(s2))
+	for key := range s {
+		if !s2.Contains(key) {
+			result[key] = struct{}{}
+		}
+	}
+	for key := range s2 {
+		if !s.Contains(key) {
+			result[key] = struct{}{}
+		}
+	}
+	return result
+}
+
+// Filter returns a new set containing only the elements for which f returns true.
+func (s Set[T]) Filter(f func(item T) bool) Set[T] {
+	result := newSet[T](0)
+	s.Each(func(item T) bool {
+		if f(item) {
+			result[item] = struct{}{}
+		}
+		return true
+	})
+	return result
+}
+
+// Any returns true if f returns true for at least one element of s.
+func (s Set[T]) Any(f func(item T) bool) bool {
+	found := false
+	s.Each(func(item T) bool {
+		if f(item) {
+			found = true
+			return false
+		}
+		return true
+	})
+	return found
+}
+
+// All returns true if f returns true for every element of s, or s is empty.
+func (s Set[T]) All(f func(item T) bool) bool {
+	ok := true
+	s.Each(func(item T) bool {
+		if !f(item) {
+			ok = false
+			return false
+		}
+		return true
+	})
+	return ok
+}
+
+// Reduce folds f over every element of s, starting from init. Since Go
+// forbids methods from introducing their own type parameters, this is a
+// free function rather than a method; U is inferred from init.
+func Reduce[T comparable, U any](s Set[T], init U, f func(acc U, item T) U) U {
+	acc := init
+	s.Each(func(item T) bool {
+		acc = f(acc, item)
+		return true
+	})
+	return acc
+}
+
+// UnionAll returns a new set with every item from every set in sets.
+func UnionAll[T comparable](sets ...Set[T]) Set[T] {
+	result := newSet[T](0)
+	for _, s := range sets {
+		result.UnionInplace(s)
+	}
+	return result
+}
+
+// IntersectionAll returns a new set with the items common to every set in
+// sets, or an empty set if sets is empty. Inputs are sorted by Len()
+// ascending first, and the walk short-circuits as soon as the running result
+// becomes empty, matching the size-based optimization Intersection already
+// uses for the pairwise case.
+func IntersectionAll[T comparable](sets ...Set[T]) Set[T] {
+	if len(sets) == 0 {
+		return newSet[T](0)
+	}
+	ordered := make([]Set[T], len(sets))
+	copy(ordered, sets)
+	sort.Slice(ordered, func(i, j int) bool { return len(ordered[i]) < len(ordered[j]) })
+
+	result := ordered[0].Clone()
+	for _, s := range ordered[1:] {
+		if len(result) == 0 {
+			break
+		}
+		result = result.Intersection(s)
+	}
+	return result
+}
+
+// DifferenceAll returns a new set with every item of base that is not
+// present in any of others.
+func DifferenceAll[T comparable](base Set[T], others ...Set[T]) Set[T] {
+	result := base.Clone()
+	for _, s := range others {
+		result = result.DifferenceInplace(s)
+	}
+	return result
+}
+
+// UnionInplace adds every item of s2 into the receiver and returns it,
+// avoiding the fresh map that Union allocates.
+func (s Set[T]) UnionInplace(s2 Set[T]) Set[T] {
+	for key := range s2 {
+		s[key] = struct{}{}
+	}
+	return s
+}
+
+// IntersectionInplace removes from the receiver every item not in s2 and
+// returns it, avoiding the fresh map that Intersection allocates. When s2 is
+// smaller than the receiver, it collects the common items by walking s2,
+// then clears the receiver and refills it with them.
+func (s Set[T]) IntersectionInplace(s2 Set[T]) Set[T] {
+	if len(s2) >= len(s) {
+		for key := range s {
+			if !s2.Contains(key) {
+				delete(s, key)
+			}
+		}
+		return s
+	}
+	keep := newSet[T](len(s2))
+	for key := range s2 {
+		if s.Contains(key) {
+			keep[key] = struct{}{}
+		}
+	}
+	for key := range s {
+		delete(s, key)
+	}
+	for key := range keep {
+		s[key] = struct{}{}
+	}
+	return s
+}
+
+// DifferenceInplace removes from the receiver every item also in s2 and
+// returns it, avoiding the fresh map that Difference allocates.
+func (s Set[T]) DifferenceInplace(s2 Set[T]) Set[T] {
+	for key := range s2 {
+		delete(s, key)
+	}
+	return s
+}
+
+// RetainAll keeps only the items in items, removing everything else from the
+// receiver, and returns it for chaining.
+func (s Set[T]) RetainAll(items ...T) Set[T] {
+	keep := newSet[T](len(items)).Insert(items...)
+	return s.IntersectionInplace(keep)
+}
+
+// RemoveAll is an alias for Delete, removing items from the receiver and
+// returning it for chaining.
+func (s Set[T]) RemoveAll(items ...T) Set[T] {
+	return s.Delete(items...)
+}
+
 //* diff slices
 
 // DifferenceSlice returns a slices of objects that are not in s2
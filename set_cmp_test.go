@@ -4,6 +4,7 @@ import (
 	"reflect"
 	"slices"
 	"sort"
+	"strconv"
 	"testing"
 )
 
@@ -222,3 +223,127 @@ func TestSetDiff(t *testing.T) {
 		}
 	})
 }
+
+func TestSetInplaceOps(t *testing.T) {
+	a := New(1, 2, 3)
+	b := New(2, 3, 4)
+
+	a.UnionInplace(b)
+	if !a.Equal(New(1, 2, 3, 4)) {
+		t.Errorf("Unexpected union: %v", a.List())
+	}
+
+	a.IntersectionInplace(New(2, 3))
+	if !a.Equal(New(2, 3)) {
+		t.Errorf("Unexpected intersection: %v", a.List())
+	}
+
+	a.DifferenceInplace(New(3))
+	if !a.Equal(New(2)) {
+		t.Errorf("Unexpected difference: %v", a.List())
+	}
+
+	c := New(1, 2, 3, 4, 5)
+	c.RetainAll(2, 4)
+	if !c.Equal(New(2, 4)) {
+		t.Errorf("Unexpected retain: %v", c.List())
+	}
+	c.RemoveAll(4)
+	if !c.Equal(New(2)) {
+		t.Errorf("Unexpected removeAll: %v", c.List())
+	}
+}
+
+func TestSetSymmetricDifference(t *testing.T) {
+	a := New(1, 2, 3)
+	b := New(1, 2, 4, 5)
+
+	c := a.SymmetricDifference(b)
+	want := []int{3, 4, 5}
+	got := c.List()
+	sort.Ints(got)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %v, Got: %v", want, got)
+	}
+	if !c.Equal(b.SymmetricDifference(a)) {
+		t.Errorf("SymmetricDifference should be commutative")
+	}
+}
+
+func TestSetFilterAnyAll(t *testing.T) {
+	s := New(1, 2, 3, 4, 5)
+
+	even := s.Filter(func(item int) bool { return item%2 == 0 })
+	if !even.Equal(New(2, 4)) {
+		t.Errorf("Unexpected filter: %v", even.List())
+	}
+
+	if !s.Any(func(item int) bool { return item == 3 }) {
+		t.Errorf("Expected Any to find 3")
+	}
+	if s.Any(func(item int) bool { return item == 10 }) {
+		t.Errorf("Expected Any to not find 10")
+	}
+
+	if !s.All(func(item int) bool { return item > 0 }) {
+		t.Errorf("Expected All items to be > 0")
+	}
+	if s.All(func(item int) bool { return item > 1 }) {
+		t.Errorf("Expected All to fail since 1 is not > 1")
+	}
+	if !New[int]().All(func(item int) bool { return false }) {
+		t.Errorf("Expected All to vacuously hold on an empty set")
+	}
+}
+
+func TestUnionAll(t *testing.T) {
+	got := UnionAll(New(1, 2), New(2, 3), New(3, 4))
+	if !got.Equal(New(1, 2, 3, 4)) {
+		t.Errorf("Unexpected union: %v", got.List())
+	}
+	if !UnionAll[int]().Equal(New[int]()) {
+		t.Errorf("Expected UnionAll() with no sets to be empty")
+	}
+}
+
+func TestIntersectionAll(t *testing.T) {
+	got := IntersectionAll(New(1, 2, 3, 4), New(2, 3, 4), New(2, 3))
+	if !got.Equal(New(2, 3)) {
+		t.Errorf("Unexpected intersection: %v", got.List())
+	}
+	if !IntersectionAll(New(1, 2), New[int]()).Equal(New[int]()) {
+		t.Errorf("Expected IntersectionAll to short-circuit to empty")
+	}
+	if !IntersectionAll[int]().Equal(New[int]()) {
+		t.Errorf("Expected IntersectionAll() with no sets to be empty")
+	}
+}
+
+func TestDifferenceAll(t *testing.T) {
+	got := DifferenceAll(New(1, 2, 3, 4), New(2), New(3))
+	if !got.Equal(New(1, 4)) {
+		t.Errorf("Unexpected difference: %v", got.List())
+	}
+	if !DifferenceAll(New(1, 2)).Equal(New(1, 2)) {
+		t.Errorf("Expected DifferenceAll with no others to equal base")
+	}
+}
+
+func TestReduce(t *testing.T) {
+	s := New(1, 2, 3, 4)
+
+	sum := Reduce(s, 0, func(acc, item int) int { return acc + item })
+	if sum != 10 {
+		t.Errorf("Expected sum=10, got %d", sum)
+	}
+
+	joined := Reduce(s, "", func(acc string, item int) string {
+		if acc == "" {
+			return strconv.Itoa(item)
+		}
+		return acc + "," + strconv.Itoa(item)
+	})
+	if len(joined) == 0 {
+		t.Errorf("Expected a non-empty joined string")
+	}
+}
@@ -0,0 +1,156 @@
+package sets
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// DuplicatePolicy controls how UnmarshalJSON on the set types in this
+// package handles a JSON array that contains the same element more than
+// once.
+type DuplicatePolicy int
+
+const (
+	// DuplicateElementsDedupe silently collapses duplicate elements, the
+	// natural behavior of inserting them into a map. This is the default.
+	DuplicateElementsDedupe DuplicatePolicy = iota
+	// DuplicateElementsReject makes UnmarshalJSON return an error instead of
+	// silently dropping duplicate elements.
+	DuplicateElementsReject
+)
+
+// UnmarshalDuplicatePolicy is a package-level switch for how UnmarshalJSON on
+// every set type handles a JSON array containing duplicate elements. It
+// defaults to DuplicateElementsDedupe; set it to DuplicateElementsReject to
+// have UnmarshalJSON fail on malformed input instead of silently deduping
+// it.
+var UnmarshalDuplicatePolicy = DuplicateElementsDedupe
+
+// checkDuplicates returns an error if items contains the same value more
+// than once and UnmarshalDuplicatePolicy is DuplicateElementsReject. It is a
+// no-op under the default DuplicateElementsDedupe policy.
+func checkDuplicates[T comparable](items []T) error {
+	if UnmarshalDuplicatePolicy != DuplicateElementsReject {
+		return nil
+	}
+	seen := make(map[T]struct{}, len(items))
+	for _, item := range items {
+		if _, ok := seen[item]; ok {
+			return fmt.Errorf("sets: duplicate element %v in JSON array", item)
+		}
+		seen[item] = struct{}{}
+	}
+	return nil
+}
+
+// sortedList returns items ordered by their canonical JSON encoding, giving
+// a deterministic order for an arbitrary comparable T without requiring the
+// Ordered constraint that List() on the numeric set types relies on.
+func sortedList[T any](items []T) ([]T, error) {
+	keys := make([]string, len(items))
+	for i, item := range items {
+		b, err := json.Marshal(item)
+		if err != nil {
+			return nil, err
+		}
+		keys[i] = string(b)
+	}
+	idx := make([]int, len(items))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.Slice(idx, func(i, j int) bool { return keys[idx[i]] < keys[idx[j]] })
+	ret := make([]T, len(items))
+	for i, j := range idx {
+		ret[i] = items[j]
+	}
+	return ret, nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the set as a JSON array of
+// its elements, sorted by their canonical JSON encoding so that two sets
+// with equal membership always marshal to byte-identical output regardless
+// of map iteration order.
+func (s Set[T]) MarshalJSON() ([]byte, error) {
+	items, err := sortedList(s.List())
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(items)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It accepts either a JSON array
+// of elements, or an object whose keys decode to T (values are ignored),
+// which is how Set[T] would otherwise round-trip through encoding/json.
+func (s *Set[T]) UnmarshalJSON(data []byte) error {
+	var items []T
+	if err := json.Unmarshal(data, &items); err == nil {
+		if err := checkDuplicates(items); err != nil {
+			return err
+		}
+		*s = newSet[T](len(items)).Insert(items...)
+		return nil
+	}
+	m := map[T]json.RawMessage{}
+	if err := json.Unmarshal(data, &m); err != nil {
+		return err
+	}
+	ret := newSet[T](len(m))
+	for k := range m {
+		ret[k] = struct{}{}
+	}
+	*s = ret
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder. Elements are sorted the same way as
+// MarshalJSON, so two sets with equal membership gob-encode identically.
+func (s Set[T]) GobEncode() ([]byte, error) {
+	items, err := sortedList(s.List())
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(items); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// GobDecode implements gob.GobDecoder.
+func (s *Set[T]) GobDecode(data []byte) error {
+	var items []T
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&items); err != nil {
+		return err
+	}
+	*s = newSet[T](len(items)).Insert(items...)
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler. T carries no numeric or
+// Ordered constraint, so there's no compact varint framing available the
+// way there is for the concrete integer set types; this delegates to the
+// same sorted gob encoding as GobEncode.
+func (s Set[T]) MarshalBinary() ([]byte, error) {
+	return s.GobEncode()
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *Set[T]) UnmarshalBinary(data []byte) error {
+	return s.GobDecode(data)
+}
+
+// Fingerprint returns an order-independent hash of the set's contents,
+// suitable for cheaply comparing or caching set states: two sets with
+// identical membership always produce the same fingerprint, regardless of
+// map iteration order.
+func (s Set[T]) Fingerprint() uint64 {
+	var fp uint64
+	for item := range s {
+		fp ^= fingerprintElement(item)
+	}
+	return fp
+}
@@ -0,0 +1,173 @@
+package sets
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestSet_JSONRoundTrip(t *testing.T) {
+	s := New(3, 1, 2)
+	data, err := json.Marshal(s)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+
+	var got Set[int]
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if !got.Equal(s) {
+		t.Errorf("Expected %v, got %v", s.List(), got.List())
+	}
+}
+
+func TestSet_UnmarshalJSON_ObjectForm(t *testing.T) {
+	var got Set[string]
+	if err := json.Unmarshal([]byte(`{"a":null,"b":null}`), &got); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if !got.Equal(New("a", "b")) {
+		t.Errorf("Expected {a,b}, got %v", got.List())
+	}
+}
+
+func TestInt16_JSONAndText(t *testing.T) {
+	s := NewInt16(3, 1, 2)
+	data, err := json.Marshal(s)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	if string(data) != "[1,2,3]" {
+		t.Errorf("Expected sorted array, got %s", data)
+	}
+
+	var got Int16
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if !got.Equal(s) {
+		t.Errorf("Expected %v, got %v", s.List(), got.List())
+	}
+
+	text, err := s.MarshalText()
+	if err != nil {
+		t.Fatalf("MarshalText failed: %v", err)
+	}
+	if string(text) != "1,2,3" {
+		t.Errorf("Expected %q, got %q", "1,2,3", text)
+	}
+
+	var roundtrip Int16
+	if err := roundtrip.UnmarshalText(text); err != nil {
+		t.Fatalf("UnmarshalText failed: %v", err)
+	}
+	if !roundtrip.Equal(s) {
+		t.Errorf("Expected %v, got %v", s.List(), roundtrip.List())
+	}
+}
+
+func TestSet_MarshalJSON_Deterministic(t *testing.T) {
+	a := New("b", "a", "c")
+	b := New("c", "b", "a")
+
+	dataA, err := a.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON failed: %v", err)
+	}
+	dataB, err := b.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON failed: %v", err)
+	}
+	if string(dataA) != string(dataB) {
+		t.Errorf("Expected byte-identical JSON, got %s and %s", dataA, dataB)
+	}
+	if a.Fingerprint() != b.Fingerprint() {
+		t.Errorf("Expected equal fingerprints for equal sets")
+	}
+}
+
+func TestSet_BinaryRoundTrip(t *testing.T) {
+	s := New(3, 1, 2)
+	data, err := s.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary failed: %v", err)
+	}
+	var got Set[int]
+	if err := got.UnmarshalBinary(data); err != nil {
+		t.Fatalf("UnmarshalBinary failed: %v", err)
+	}
+	if !got.Equal(s) {
+		t.Errorf("Expected %v, got %v", s.List(), got.List())
+	}
+}
+
+func TestInt32_JSONAndGobRoundTrip(t *testing.T) {
+	s := NewInt32(3, 1, 2)
+	data, err := json.Marshal(s)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	if string(data) != "[1,2,3]" {
+		t.Errorf("Expected sorted array, got %s", data)
+	}
+
+	var got Int32
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if !got.Equal(s) {
+		t.Errorf("Expected %v, got %v", s.List(), got.List())
+	}
+
+	gobData, err := s.GobEncode()
+	if err != nil {
+		t.Fatalf("GobEncode failed: %v", err)
+	}
+	var gobGot Int32
+	if err := gobGot.GobDecode(gobData); err != nil {
+		t.Fatalf("GobDecode failed: %v", err)
+	}
+	if !gobGot.Equal(s) {
+		t.Errorf("Expected %v, got %v", s.List(), gobGot.List())
+	}
+}
+
+func TestUnmarshalDuplicatePolicy(t *testing.T) {
+	old := UnmarshalDuplicatePolicy
+	defer func() { UnmarshalDuplicatePolicy = old }()
+
+	UnmarshalDuplicatePolicy = DuplicateElementsDedupe
+	var deduped Set[int]
+	if err := json.Unmarshal([]byte(`[1,2,2,3]`), &deduped); err != nil {
+		t.Fatalf("Unmarshal failed under dedupe policy: %v", err)
+	}
+	if !deduped.Equal(New(1, 2, 3)) {
+		t.Errorf("Expected duplicates silently deduped, got %v", deduped.List())
+	}
+
+	UnmarshalDuplicatePolicy = DuplicateElementsReject
+	var rejected Set[int]
+	if err := json.Unmarshal([]byte(`[1,2,2,3]`), &rejected); err == nil {
+		t.Errorf("Expected an error for duplicate elements under reject policy")
+	}
+
+	var noDupes Set[int]
+	if err := json.Unmarshal([]byte(`[1,2,3]`), &noDupes); err != nil {
+		t.Errorf("Expected no error for duplicate-free input under reject policy: %v", err)
+	}
+}
+
+func TestUint8_GobRoundTrip(t *testing.T) {
+	s := NewUint8(1, 2, 3)
+	data, err := s.GobEncode()
+	if err != nil {
+		t.Fatalf("GobEncode failed: %v", err)
+	}
+	var got Uint8
+	if err := got.GobDecode(data); err != nil {
+		t.Fatalf("GobDecode failed: %v", err)
+	}
+	if !got.Equal(s) {
+		t.Errorf("Expected %v, got %v", s.List(), got.List())
+	}
+}
@@ -0,0 +1,108 @@
+//go:build go1.23
+
+package sets
+
+import (
+	"fmt"
+	"iter"
+)
+
+// maxPowerSetLen is the largest set size PowerSet will enumerate; 2^64
+// subsets would never terminate and 2^n overflows a uint64 mask beyond this.
+const maxPowerSetLen = 63
+
+// CartesianProduct returns an iterator over every pair (x, y) with x in a
+// and y in b, without materializing the product set up front. Callers can
+// stop early by returning false from the range-over-func body.
+func CartesianProduct[A, B comparable](a Set[A], b Set[B]) iter.Seq2[A, B] {
+	return func(yield func(A, B) bool) {
+		for x := range a {
+			for y := range b {
+				if !yield(x, y) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// PowerSet returns an iterator over every subset of s, including the empty
+// set and s itself. Subsets are enumerated via a uint64 bitmask over
+// s.List(), so s must have at most 63 elements; larger sets panic, since the
+// number of subsets would otherwise overflow the mask.
+func PowerSet[T comparable](s Set[T]) iter.Seq[Set[T]] {
+	items := s.List()
+	if len(items) > maxPowerSetLen {
+		panic(fmt.Sprintf("sets: PowerSet: set has %d elements, max supported is %d", len(items), maxPowerSetLen))
+	}
+	total := uint64(1) << len(items)
+	return func(yield func(Set[T]) bool) {
+		for mask := uint64(0); mask < total; mask++ {
+			subset := newSet[T](bitsOnesCount(mask))
+			for i, item := range items {
+				if mask&(1<<i) != 0 {
+					subset[item] = struct{}{}
+				}
+			}
+			if !yield(subset) {
+				return
+			}
+		}
+	}
+}
+
+func bitsOnesCount(mask uint64) int {
+	n := 0
+	for mask != 0 {
+		n++
+		mask &= mask - 1
+	}
+	return n
+}
+
+// Pair is an ordered pair of values, used as the element type of
+// CartesianProductSet's materialized result.
+type Pair[A, B comparable] struct {
+	First  A
+	Second B
+}
+
+// CartesianProductSet returns the Cartesian product of a and b as a
+// materialized Set[Pair[A,B]]. This allocates len(a)*len(b) pairs up front;
+// callers who want to avoid that, or who may stop early, should use the lazy
+// CartesianProduct iterator above instead.
+func CartesianProductSet[A, B comparable](a Set[A], b Set[B]) Set[Pair[A, B]] {
+	result := newSet[Pair[A, B]](len(a) * len(b))
+	for x := range a {
+		for y := range b {
+			result[Pair[A, B]{First: x, Second: y}] = struct{}{}
+		}
+	}
+	return result
+}
+
+// PowerSetSlice returns every subset of s, including the empty set and s
+// itself, as a []Set[T], materialized up front rather than lazily produced.
+// This is exponential in len(s): a 20-element set already produces over a
+// million subsets. s must have at most maxPowerSetLen elements; larger sets
+// return an error rather than panicking, since eagerly materializing the
+// whole power set makes the cost of getting this wrong much higher than for
+// the lazy PowerSet iterator above.
+func PowerSetSlice[T comparable](s Set[T]) ([]Set[T], error) {
+	items := s.List()
+	if len(items) > maxPowerSetLen {
+		return nil, fmt.Errorf("sets: PowerSetSlice: set has %d elements, max supported is %d", len(items), maxPowerSetLen)
+	}
+	total := uint64(1) << len(items)
+	result := make([]Set[T], 0, total)
+	for mask := uint64(0); mask < total; mask++ {
+		subset := newSet[T](bitsOnesCount(mask))
+		for i, item := range items {
+			if mask&(1<<i) != 0 {
+				subset[item] = struct{}{}
+			}
+		}
+		result = append(result, subset)
+	}
+	return result, nil
+}
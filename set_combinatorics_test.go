@@ -0,0 +1,108 @@
+//go:build go1.23
+
+package sets
+
+import "testing"
+
+func TestCartesianProduct(t *testing.T) {
+	a := New(1, 2)
+	b := New("x", "y")
+
+	count := 0
+	for x, y := range CartesianProduct(a, b) {
+		if !a.Contains(x) || !b.Contains(y) {
+			t.Errorf("Unexpected pair: %v, %v", x, y)
+		}
+		count++
+	}
+	if count != 4 {
+		t.Errorf("Expected 4 pairs, got %d", count)
+	}
+}
+
+func TestCartesianProduct_EarlyStop(t *testing.T) {
+	a := New(1, 2, 3)
+	b := New(1, 2, 3)
+
+	count := 0
+	for range CartesianProduct(a, b) {
+		count++
+		if count == 2 {
+			break
+		}
+	}
+	if count != 2 {
+		t.Errorf("Expected early stop at 2, got %d", count)
+	}
+}
+
+func TestPowerSet(t *testing.T) {
+	s := New(1, 2, 3)
+
+	count := 0
+	for subset := range PowerSet(s) {
+		if !s.IsSuperset(subset) {
+			t.Errorf("Unexpected subset: %v", subset.List())
+		}
+		count++
+	}
+	if count != 8 {
+		t.Errorf("Expected 8 subsets, got %d", count)
+	}
+}
+
+func TestPowerSet_TooLarge(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Expected panic for oversized set")
+		}
+	}()
+	items := make([]int, 64)
+	for i := range items {
+		items[i] = i
+	}
+	s := New(items...)
+	for range PowerSet(s) {
+	}
+}
+
+func TestCartesianProductSet(t *testing.T) {
+	a := New(1, 2)
+	b := New("x", "y")
+
+	product := CartesianProductSet(a, b)
+	if product.Len() != 4 {
+		t.Errorf("Expected 4 pairs, got %d", product.Len())
+	}
+	if !product.Contains(Pair[int, string]{First: 1, Second: "x"}) {
+		t.Errorf("Expected pair {1, x} in product: %v", product.List())
+	}
+}
+
+func TestPowerSetSlice(t *testing.T) {
+	s := New(1, 2, 3)
+
+	subsets, err := PowerSetSlice(s)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(subsets) != 8 {
+		t.Errorf("Expected 8 subsets, got %d", len(subsets))
+	}
+	for _, subset := range subsets {
+		if !s.IsSuperset(subset) {
+			t.Errorf("Unexpected subset: %v", subset.List())
+		}
+	}
+}
+
+func TestPowerSetSlice_TooLarge(t *testing.T) {
+	items := make([]int, 64)
+	for i := range items {
+		items[i] = i
+	}
+	s := New(items...)
+	if _, err := PowerSetSlice(s); err == nil {
+		t.Errorf("Expected an error for oversized set")
+	}
+}
@@ -3,7 +3,9 @@
 package sets
 
 import (
+	"cmp"
 	"iter"
+	"sort"
 )
 
 func (s Set[T]) Values() iter.Seq[T] {
@@ -15,3 +17,31 @@ func (s Set[T]) Values() iter.Seq[T] {
 		}
 	}
 }
+
+// SortedValues returns an iterator over s's elements in ascending order.
+// T must be cmp.Ordered, which Set[T]'s own comparable constraint does not
+// guarantee, so this is a package-level function rather than a method.
+func SortedValues[T cmp.Ordered](s Set[T]) iter.Seq[T] {
+	items := s.List()
+	sort.Slice(items, func(i, j int) bool { return items[i] < items[j] })
+	return func(yield func(T) bool) {
+		for _, v := range items {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Collect creates a Set[T] from seq, e.g. maps.Keys or slices.Values.
+func Collect[T comparable](seq iter.Seq[T]) Set[T] {
+	return newSet[T](0).AppendSeq(seq)
+}
+
+// AppendSeq inserts every value from seq into s, returning s for chaining.
+func (s Set[T]) AppendSeq(seq iter.Seq[T]) Set[T] {
+	for v := range seq {
+		s[v] = struct{}{}
+	}
+	return s
+}
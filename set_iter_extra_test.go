@@ -0,0 +1,71 @@
+//go:build go1.23
+
+package sets
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func Test_Iter_SortedValues(t *testing.T) {
+	s := New(3, 1, 4, 1, 5)
+	got := slices.Collect(SortedValues(s))
+	if want := []int{1, 3, 4, 5}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+}
+
+func Test_Iter_Collect(t *testing.T) {
+	s := Collect(slices.Values([]int{1, 2, 2, 3}))
+	if !s.Equal(New(1, 2, 3)) {
+		t.Errorf("Unexpected set: %v", s.List())
+	}
+}
+
+func Test_Iter_AppendSeq(t *testing.T) {
+	s := New(1, 2)
+	s.AppendSeq(slices.Values([]int{2, 3, 4}))
+	if !s.Equal(New(1, 2, 3, 4)) {
+		t.Errorf("Unexpected set: %v", s.List())
+	}
+}
+
+func Test_Typed_Values(t *testing.T) {
+	s := NewInt16(1, 2, 3)
+	count := 0
+	for range s.Values() {
+		count++
+	}
+	if count != 3 {
+		t.Errorf("Expected 3 values, got %d", count)
+	}
+	if got, want := slices.Collect(s.SortedValues()), []int16{1, 2, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+}
+
+func Test_OrderedSet_Values(t *testing.T) {
+	s := NewOrdered(3, 1, 2)
+	if got, want := slices.Collect(s.Values()), []int{3, 1, 2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+}
+
+func Test_OrderedSet_All(t *testing.T) {
+	s := NewOrdered("a", "b", "c")
+	s.Delete("b")
+
+	var indices []int
+	var values []string
+	for i, v := range s.All() {
+		indices = append(indices, i)
+		values = append(values, v)
+	}
+	if want := []int{0, 1}; !reflect.DeepEqual(indices, want) {
+		t.Errorf("Expected indices %v, got %v", want, indices)
+	}
+	if want := []string{"a", "c"}; !reflect.DeepEqual(values, want) {
+		t.Errorf("Expected values %v, got %v", want, values)
+	}
+}
@@ -0,0 +1,152 @@
+package sets
+
+import (
+	"fmt"
+	"hash/fnv"
+	"sync"
+)
+
+// defaultShardCount is the number of shards a ShardedSet uses when none is
+// given explicitly.
+const defaultShardCount = 32
+
+// ShardedSet is a concurrent set that hashes keys across N independent
+// Set[T] shards, each guarded by its own sync.RWMutex, so that Insert and
+// Contains calls on unrelated keys don't serialize the way they would
+// behind a single SyncSet's lock.
+type ShardedSet[T comparable] struct {
+	shards []*syncShard[T]
+}
+
+type syncShard[T comparable] struct {
+	mu sync.RWMutex
+	s  Set[T]
+}
+
+// NewSharded creates a ShardedSet with n shards (defaultShardCount if n <=
+// 0) from a list of values.
+func NewSharded[T comparable](n int, items ...T) *ShardedSet[T] {
+	if n <= 0 {
+		n = defaultShardCount
+	}
+	shards := make([]*syncShard[T], n)
+	for i := range shards {
+		shards[i] = &syncShard[T]{s: Set[T]{}}
+	}
+	s := &ShardedSet[T]{shards: shards}
+	s.Insert(items...)
+	return s
+}
+
+// shardFor returns the shard responsible for item, hashing it via its
+// fmt.Sprintf("%v", ...) representation since Go generics give us no
+// Hash() constraint for an arbitrary comparable type.
+func (s *ShardedSet[T]) shardFor(item T) *syncShard[T] {
+	h := fnv.New64a()
+	fmt.Fprintf(h, "%v", item)
+	return s.shards[h.Sum64()%uint64(len(s.shards))]
+}
+
+// Insert adds items to the set.
+func (s *ShardedSet[T]) Insert(items ...T) *ShardedSet[T] {
+	for _, item := range items {
+		shard := s.shardFor(item)
+		shard.mu.Lock()
+		shard.s.Insert(item)
+		shard.mu.Unlock()
+	}
+	return s
+}
+
+// Delete removes all items from the set.
+func (s *ShardedSet[T]) Delete(items ...T) *ShardedSet[T] {
+	for _, item := range items {
+		shard := s.shardFor(item)
+		shard.mu.Lock()
+		shard.s.Delete(item)
+		shard.mu.Unlock()
+	}
+	return s
+}
+
+// Contains returns true if and only if item is contained in the set.
+func (s *ShardedSet[T]) Contains(item T) bool {
+	shard := s.shardFor(item)
+	shard.mu.RLock()
+	defer shard.mu.RUnlock()
+	return shard.s.Contains(item)
+}
+
+// ShardCount returns the number of shards s was created with.
+func (s *ShardedSet[T]) ShardCount() int {
+	return len(s.shards)
+}
+
+// Len returns the size of the set. Shards are locked one at a time, in
+// index order, so this never contends with a concurrent cross-shard
+// operation on the same ShardedSet.
+func (s *ShardedSet[T]) Len() int {
+	n := 0
+	for _, shard := range s.shards {
+		shard.mu.RLock()
+		n += shard.s.Len()
+		shard.mu.RUnlock()
+	}
+	return n
+}
+
+// Snapshot returns an immutable-in-spirit copy of the set as a plain Set[T],
+// taken by locking shards one at a time in index order.
+func (s *ShardedSet[T]) Snapshot() Set[T] {
+	result := newSet[T](s.Len())
+	for _, shard := range s.shards {
+		shard.mu.RLock()
+		for item := range shard.s {
+			result[item] = struct{}{}
+		}
+		shard.mu.RUnlock()
+	}
+	return result
+}
+
+// List returns a snapshot of the contents as a slice.
+func (s *ShardedSet[T]) List() []T {
+	return s.Snapshot().List()
+}
+
+// Each traverses a snapshot of the set, calling the provided function for
+// each member. Traversal will continue until all items have been visited,
+// or if the closure returns false.
+func (s *ShardedSet[T]) Each(f func(item T) bool) {
+	for item := range s.Snapshot() {
+		if !f(item) {
+			return
+		}
+	}
+}
+
+// Clone returns a new ShardedSet, with the same shard count, containing a
+// copy of s.
+func (s *ShardedSet[T]) Clone() *ShardedSet[T] {
+	return NewSharded[T](len(s.shards), s.List()...)
+}
+
+// Union returns a new ShardedSet which includes items in either s or s2.
+// Each side is snapshotted independently, so no two shard locks (from s or
+// s2) are ever held at once.
+func (s *ShardedSet[T]) Union(s2 *ShardedSet[T]) *ShardedSet[T] {
+	return NewSharded[T](len(s.shards), s.Snapshot().Union(s2.Snapshot()).List()...)
+}
+
+// Intersection returns a new ShardedSet which includes the items in BOTH s
+// and s2.
+func (s *ShardedSet[T]) Intersection(s2 *ShardedSet[T]) *ShardedSet[T] {
+	return NewSharded[T](len(s.shards), s.Snapshot().Intersection(s2.Snapshot()).List()...)
+}
+
+// Diff returns s diff of s2, return added, removed, remained sets.
+func (s *ShardedSet[T]) Diff(s2 *ShardedSet[T]) (added, removed, remained *ShardedSet[T]) {
+	a, r, m := s.Snapshot().Diff(s2.Snapshot())
+	n := len(s.shards)
+	return NewSharded[T](n, a.List()...), NewSharded[T](n, r.List()...), NewSharded[T](n, m.List()...)
+}
@@ -0,0 +1,66 @@
+package sets
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestShardedSet_ConcurrentInsert(t *testing.T) {
+	s := NewSharded[int](8)
+	var wg sync.WaitGroup
+	for i := 0; i < 200; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			s.Insert(i)
+		}(i)
+	}
+	wg.Wait()
+	if s.Len() != 200 {
+		t.Errorf("Expected len=200: %d", s.Len())
+	}
+}
+
+func TestShardedSet_DefaultShardCount(t *testing.T) {
+	s := NewSharded[string](0, "a", "b")
+	if len(s.shards) != defaultShardCount {
+		t.Errorf("Expected %d shards, got %d", defaultShardCount, len(s.shards))
+	}
+}
+
+func TestShardedSet_Ops(t *testing.T) {
+	a := NewSharded[int](4, 1, 2, 3)
+	b := NewSharded[int](4, 2, 3, 4)
+
+	union := a.Union(b)
+	if !union.Snapshot().Equal(New(1, 2, 3, 4)) {
+		t.Errorf("Unexpected union: %v", union.List())
+	}
+
+	inter := a.Intersection(b)
+	if !inter.Snapshot().Equal(New(2, 3)) {
+		t.Errorf("Unexpected intersection: %v", inter.List())
+	}
+
+	added, removed, remained := a.Diff(b)
+	if !added.Snapshot().Equal(New(4)) {
+		t.Errorf("Unexpected added: %v", added.List())
+	}
+	if !removed.Snapshot().Equal(New(1)) {
+		t.Errorf("Unexpected removed: %v", removed.List())
+	}
+	if !remained.Snapshot().Equal(New(2, 3)) {
+		t.Errorf("Unexpected remained: %v", remained.List())
+	}
+}
+
+func TestShardedSet_DeleteAndContains(t *testing.T) {
+	s := NewSharded[int](4, 1, 2, 3)
+	s.Delete(2)
+	if s.Contains(2) {
+		t.Errorf("Unexpected contents: %v", s.List())
+	}
+	if !s.Contains(1) || !s.Contains(3) {
+		t.Errorf("Missing contents: %v", s.List())
+	}
+}
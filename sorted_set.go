@@ -0,0 +1,378 @@
+package sets
+
+// sortedNode is a node of the AVL tree backing SortedSet.
+type sortedNode[T any] struct {
+	value       T
+	left, right *sortedNode[T]
+	height      int
+}
+
+func sortedNodeHeight[T any](n *sortedNode[T]) int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+func sortedNodeBalance[T any](n *sortedNode[T]) int {
+	if n == nil {
+		return 0
+	}
+	return sortedNodeHeight(n.left) - sortedNodeHeight(n.right)
+}
+
+func sortedUpdateHeight[T any](n *sortedNode[T]) {
+	l, r := sortedNodeHeight(n.left), sortedNodeHeight(n.right)
+	if l > r {
+		n.height = l + 1
+	} else {
+		n.height = r + 1
+	}
+}
+
+func sortedRotateRight[T any](y *sortedNode[T]) *sortedNode[T] {
+	x := y.left
+	t2 := x.right
+	x.right = y
+	y.left = t2
+	sortedUpdateHeight(y)
+	sortedUpdateHeight(x)
+	return x
+}
+
+func sortedRotateLeft[T any](x *sortedNode[T]) *sortedNode[T] {
+	y := x.right
+	t2 := y.left
+	y.left = x
+	x.right = t2
+	sortedUpdateHeight(x)
+	sortedUpdateHeight(y)
+	return y
+}
+
+func sortedRebalance[T any](n *sortedNode[T]) *sortedNode[T] {
+	sortedUpdateHeight(n)
+	balance := sortedNodeBalance(n)
+	if balance > 1 {
+		if sortedNodeBalance(n.left) < 0 {
+			n.left = sortedRotateLeft(n.left)
+		}
+		return sortedRotateRight(n)
+	}
+	if balance < -1 {
+		if sortedNodeBalance(n.right) > 0 {
+			n.right = sortedRotateRight(n.right)
+		}
+		return sortedRotateLeft(n)
+	}
+	return n
+}
+
+// SortedSet is a set of elements ordered by a user-supplied less function,
+// stored in a self-balancing AVL tree. Unlike TreeSet, which compares
+// elements through the Comparator interface, SortedSet takes a plain
+// `less func(a, b T) bool`, so it reads naturally with closures over typed
+// keys. It keeps elements in sorted order at all times, giving O(log n)
+// membership plus O(log n + k) range scans, in contrast to Int64.List(),
+// which pays an O(n log n) sort on every call.
+type SortedSet[T any] struct {
+	root *sortedNode[T]
+	less func(a, b T) bool
+	size int
+}
+
+// NewSorted creates a SortedSet ordered by less from a list of values.
+func NewSorted[T any](less func(a, b T) bool, items ...T) *SortedSet[T] {
+	s := &SortedSet[T]{less: less}
+	s.Insert(items...)
+	return s
+}
+
+// Insert adds items to the set.
+func (s *SortedSet[T]) Insert(items ...T) *SortedSet[T] {
+	for _, item := range items {
+		s.root = s.insert(s.root, item)
+	}
+	return s
+}
+
+func (s *SortedSet[T]) insert(n *sortedNode[T], value T) *sortedNode[T] {
+	if n == nil {
+		s.size++
+		return &sortedNode[T]{value: value, height: 1}
+	}
+	switch {
+	case s.less(value, n.value):
+		n.left = s.insert(n.left, value)
+	case s.less(n.value, value):
+		n.right = s.insert(n.right, value)
+	default:
+		n.value = value
+		return n
+	}
+	return sortedRebalance(n)
+}
+
+// Delete removes all items from the set.
+func (s *SortedSet[T]) Delete(items ...T) *SortedSet[T] {
+	for _, item := range items {
+		s.root = s.delete(s.root, item)
+	}
+	return s
+}
+
+func (s *SortedSet[T]) delete(n *sortedNode[T], value T) *sortedNode[T] {
+	if n == nil {
+		return nil
+	}
+	switch {
+	case s.less(value, n.value):
+		n.left = s.delete(n.left, value)
+	case s.less(n.value, value):
+		n.right = s.delete(n.right, value)
+	default:
+		if n.left == nil {
+			s.size--
+			return n.right
+		}
+		if n.right == nil {
+			s.size--
+			return n.left
+		}
+		succ := n.right
+		for succ.left != nil {
+			succ = succ.left
+		}
+		n.value = succ.value
+		// The successor always has no left child, so this recursive call
+		// takes the n.left == nil branch above and performs the size--.
+		n.right = s.delete(n.right, succ.value)
+		return sortedRebalance(n)
+	}
+	return sortedRebalance(n)
+}
+
+// Contains returns true if and only if item is contained in the set.
+func (s *SortedSet[T]) Contains(item T) bool {
+	n := s.root
+	for n != nil {
+		switch {
+		case s.less(item, n.value):
+			n = n.left
+		case s.less(n.value, item):
+			n = n.right
+		default:
+			return true
+		}
+	}
+	return false
+}
+
+// Len returns the size of the set.
+func (s *SortedSet[T]) Len() int {
+	return s.size
+}
+
+// Min returns the smallest element of the set.
+func (s *SortedSet[T]) Min() (v T, ok bool) {
+	if s.root == nil {
+		return v, false
+	}
+	n := s.root
+	for n.left != nil {
+		n = n.left
+	}
+	return n.value, true
+}
+
+// Max returns the largest element of the set.
+func (s *SortedSet[T]) Max() (v T, ok bool) {
+	if s.root == nil {
+		return v, false
+	}
+	n := s.root
+	for n.right != nil {
+		n = n.right
+	}
+	return n.value, true
+}
+
+// Floor returns the largest element <= value, if any.
+func (s *SortedSet[T]) Floor(value T) (v T, ok bool) {
+	n := s.root
+	for n != nil {
+		switch {
+		case s.less(value, n.value):
+			n = n.left
+		case s.less(n.value, value):
+			v, ok = n.value, true
+			n = n.right
+		default:
+			return n.value, true
+		}
+	}
+	return v, ok
+}
+
+// Ceiling returns the smallest element >= value, if any.
+func (s *SortedSet[T]) Ceiling(value T) (v T, ok bool) {
+	n := s.root
+	for n != nil {
+		switch {
+		case s.less(n.value, value):
+			n = n.right
+		case s.less(value, n.value):
+			v, ok = n.value, true
+			n = n.left
+		default:
+			return n.value, true
+		}
+	}
+	return v, ok
+}
+
+// Each visits every element in ascending order, calling f for each. The
+// traversal stops early if f returns false.
+func (s *SortedSet[T]) Each(f func(item T) bool) {
+	var walk func(n *sortedNode[T]) bool
+	walk = func(n *sortedNode[T]) bool {
+		if n == nil {
+			return true
+		}
+		if !walk(n.left) {
+			return false
+		}
+		if !f(n.value) {
+			return false
+		}
+		return walk(n.right)
+	}
+	walk(s.root)
+}
+
+// RangeFrom visits every element v with lo <= v <= hi in ascending order,
+// calling f for each. The traversal stops early if f returns false.
+func (s *SortedSet[T]) RangeFrom(lo, hi T, f func(item T) bool) {
+	var walk func(n *sortedNode[T]) bool
+	walk = func(n *sortedNode[T]) bool {
+		if n == nil {
+			return true
+		}
+		if s.less(lo, n.value) {
+			if !walk(n.left) {
+				return false
+			}
+		}
+		if !s.less(n.value, lo) && !s.less(hi, n.value) {
+			if !f(n.value) {
+				return false
+			}
+		}
+		if s.less(n.value, hi) {
+			return walk(n.right)
+		}
+		return true
+	}
+	walk(s.root)
+}
+
+// List returns the elements already in sorted order, without a sort pass.
+func (s *SortedSet[T]) List() []T {
+	res := make([]T, 0, s.size)
+	s.Each(func(item T) bool {
+		res = append(res, item)
+		return true
+	})
+	return res
+}
+
+// Clone returns a new SortedSet with a copy of s.
+func (s *SortedSet[T]) Clone() *SortedSet[T] {
+	return NewSorted(s.less, s.List()...)
+}
+
+// Union returns a new set which includes items in either s or s2.
+func (s *SortedSet[T]) Union(s2 *SortedSet[T]) *SortedSet[T] {
+	result := s.Clone()
+	result.Insert(s2.List()...)
+	return result
+}
+
+// Intersection returns a new set which includes the items in BOTH s and s2.
+func (s *SortedSet[T]) Intersection(s2 *SortedSet[T]) *SortedSet[T] {
+	result := NewSorted[T](s.less)
+	s.Each(func(item T) bool {
+		if s2.Contains(item) {
+			result.Insert(item)
+		}
+		return true
+	})
+	return result
+}
+
+// Difference returns a set of objects from s that are not in s2.
+func (s *SortedSet[T]) Difference(s2 *SortedSet[T]) *SortedSet[T] {
+	result := NewSorted[T](s.less)
+	s.Each(func(item T) bool {
+		if !s2.Contains(item) {
+			result.Insert(item)
+		}
+		return true
+	})
+	return result
+}
+
+// Equal returns true if and only if s is equal (as a set) to s2.
+func (s *SortedSet[T]) Equal(s2 *SortedSet[T]) bool {
+	if s.Len() != s2.Len() {
+		return false
+	}
+	equal := true
+	s.Each(func(item T) bool {
+		if !s2.Contains(item) {
+			equal = false
+			return false
+		}
+		return true
+	})
+	return equal
+}
+
+// Diff returns s diff of s2, return added, removed, remained sets, each in
+// ascending order.
+// For example:
+// s1 = {1, 3, 5, 7}
+// s2 = {3, 4, 5, 6}
+// added = {4, 6}
+// removed = {1, 7}
+// remained = {3, 5}
+func (s *SortedSet[T]) Diff(s2 *SortedSet[T]) (added, removed, remained *SortedSet[T]) {
+	added, removed, remained = NewSorted[T](s.less), NewSorted[T](s.less), NewSorted[T](s.less)
+	s.Each(func(item T) bool {
+		if s2.Contains(item) {
+			remained.Insert(item)
+		} else {
+			removed.Insert(item)
+		}
+		return true
+	})
+	s2.Each(func(item T) bool {
+		if !s.Contains(item) {
+			added.Insert(item)
+		}
+		return true
+	})
+	return added, removed, remained
+}
+
+// DiffVary returns s diff of s2, return added, removed sets, each in
+// ascending order.
+// For example:
+// s1 = {1, 3, 5, 7}
+// s2 = {3, 4, 5, 6}
+// added = {4, 6}
+// removed = {1, 7}
+func (s *SortedSet[T]) DiffVary(s2 *SortedSet[T]) (added, removed *SortedSet[T]) {
+	added, removed, _ = s.Diff(s2)
+	return added, removed
+}
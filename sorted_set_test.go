@@ -0,0 +1,110 @@
+package sets
+
+import "testing"
+
+func intLess(a, b int) bool { return a < b }
+
+func TestSortedSet_InsertContainsDelete(t *testing.T) {
+	s := NewSorted(intLess, 5, 3, 8, 1, 4, 7, 9)
+	if s.Len() != 7 {
+		t.Errorf("Expected len=7: %d", s.Len())
+	}
+	if !s.Contains(4) {
+		t.Errorf("Missing contents: %#v", s.List())
+	}
+	s.Delete(4, 8)
+	if s.Len() != 5 {
+		t.Errorf("Expected len=5: %d", s.Len())
+	}
+	if s.Contains(4) || s.Contains(8) {
+		t.Errorf("Unexpected contents: %#v", s.List())
+	}
+}
+
+func TestSortedSet_OrderedList(t *testing.T) {
+	s := NewSorted(intLess, 5, 3, 8, 1, 4)
+	want := []int{1, 3, 4, 5, 8}
+	got := s.List()
+	if len(got) != len(want) {
+		t.Fatalf("Expected %v, got %v", want, got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Expected %v, got %v", want, got)
+		}
+	}
+}
+
+func TestSortedSet_MinMaxFloorCeiling(t *testing.T) {
+	s := NewSorted(intLess, 10, 20, 30, 40)
+
+	if min, ok := s.Min(); !ok || min != 10 {
+		t.Errorf("Expected min=10, got %v, %v", min, ok)
+	}
+	if max, ok := s.Max(); !ok || max != 40 {
+		t.Errorf("Expected max=40, got %v, %v", max, ok)
+	}
+	if f, ok := s.Floor(25); !ok || f != 20 {
+		t.Errorf("Expected floor(25)=20, got %v, %v", f, ok)
+	}
+	if c, ok := s.Ceiling(25); !ok || c != 30 {
+		t.Errorf("Expected ceiling(25)=30, got %v, %v", c, ok)
+	}
+	if _, ok := s.Floor(5); ok {
+		t.Errorf("Expected no floor below min")
+	}
+}
+
+func TestSortedSet_RangeFrom(t *testing.T) {
+	s := NewSorted(intLess, 1, 2, 3, 4, 5, 6)
+	var got []int
+	s.RangeFrom(2, 5, func(item int) bool {
+		got = append(got, item)
+		return true
+	})
+	want := []int{2, 3, 4, 5}
+	if len(got) != len(want) {
+		t.Fatalf("Expected %v, got %v", want, got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Expected %v, got %v", want, got)
+		}
+	}
+}
+
+func TestSortedSet_SetOps(t *testing.T) {
+	a := NewSorted(intLess, 1, 2, 3)
+	b := NewSorted(intLess, 2, 3, 4)
+
+	if !a.Union(b).Equal(NewSorted(intLess, 1, 2, 3, 4)) {
+		t.Errorf("Unexpected union")
+	}
+	if !a.Intersection(b).Equal(NewSorted(intLess, 2, 3)) {
+		t.Errorf("Unexpected intersection")
+	}
+	if !a.Difference(b).Equal(NewSorted(intLess, 1)) {
+		t.Errorf("Unexpected difference")
+	}
+}
+
+func TestSortedSet_Diff(t *testing.T) {
+	a := NewSorted(intLess, 1, 3, 5, 7)
+	b := NewSorted(intLess, 3, 4, 5, 6)
+
+	added, removed, remained := a.Diff(b)
+	if !added.Equal(NewSorted(intLess, 4, 6)) {
+		t.Errorf("Unexpected added: %v", added.List())
+	}
+	if !removed.Equal(NewSorted(intLess, 1, 7)) {
+		t.Errorf("Unexpected removed: %v", removed.List())
+	}
+	if !remained.Equal(NewSorted(intLess, 3, 5)) {
+		t.Errorf("Unexpected remained: %v", remained.List())
+	}
+
+	addedVary, removedVary := a.DiffVary(b)
+	if !addedVary.Equal(added) || !removedVary.Equal(removed) {
+		t.Errorf("DiffVary mismatch with Diff")
+	}
+}
@@ -0,0 +1,251 @@
+package sets
+
+import (
+	"sync"
+	"unsafe"
+)
+
+// Interface is the read-only surface shared by both the unsynchronized
+// map-based sets (Set[T]) and the synchronized wrappers (SyncSet[T]) in this
+// package, so callers that only need to query a set can accept either
+// implementation without depending on a concrete type.
+type Interface[T comparable] interface {
+	Contains(item T) bool
+	ContainsAll(items ...T) bool
+	ContainsAny(items ...T) bool
+	Len() int
+	List() []T
+	Each(f func(item T) bool)
+}
+
+var (
+	_ Interface[int] = Set[int](nil)
+	_ Interface[int] = (*SyncSet[int])(nil)
+)
+
+// SyncSet is a Set[T] guarded by a sync.RWMutex, for callers that read or
+// mutate a set from multiple goroutines. Set[T] itself stays lock-free for
+// the common single-goroutine case.
+type SyncSet[T comparable] struct {
+	mu sync.RWMutex
+	s  Set[T]
+}
+
+// NewSync creates a SyncSet from a list of values.
+func NewSync[T comparable](items ...T) *SyncSet[T] {
+	return &SyncSet[T]{s: New(items...)}
+}
+
+// Insert adds items to the set.
+func (s *SyncSet[T]) Insert(items ...T) *SyncSet[T] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.s.Insert(items...)
+	return s
+}
+
+// Delete removes all items from the set.
+func (s *SyncSet[T]) Delete(items ...T) *SyncSet[T] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.s.Delete(items...)
+	return s
+}
+
+// Contains returns true if and only if item is contained in the set.
+func (s *SyncSet[T]) Contains(item T) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.s.Contains(item)
+}
+
+// ContainsAll returns true if and only if all items are contained in the set.
+func (s *SyncSet[T]) ContainsAll(items ...T) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.s.ContainsAll(items...)
+}
+
+// ContainsAny returns true if any items are contained in the set.
+func (s *SyncSet[T]) ContainsAny(items ...T) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.s.ContainsAny(items...)
+}
+
+// Len returns the size of the set.
+func (s *SyncSet[T]) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.s.Len()
+}
+
+// List returns a snapshot of the contents as a slice in unspecified order.
+func (s *SyncSet[T]) List() []T {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.s.List()
+}
+
+// Each traverses a snapshot of the set taken under RLock, calling the
+// provided function for each member. The lock is released before f is
+// invoked, so f may itself call back into s without deadlocking.
+func (s *SyncSet[T]) Each(f func(item T) bool) {
+	for _, item := range s.List() {
+		if !f(item) {
+			return
+		}
+	}
+}
+
+// Clone returns a new SyncSet with a copy of s.
+func (s *SyncSet[T]) Clone() *SyncSet[T] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return &SyncSet[T]{s: s.s.Clone()}
+}
+
+// Pop returns and removes a single element from the set.
+func (s *SyncSet[T]) Pop() (v T, ok bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.s.Pop()
+}
+
+// PopN returns and removes up to n elements from the set. If the set has
+// fewer than n elements, the returned slice is shorter than n.
+func (s *SyncSet[T]) PopN(n int) []T {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if n > len(s.s) {
+		n = len(s.s)
+	}
+	result := make([]T, 0, n)
+	for i := 0; i < n; i++ {
+		v, ok := s.s.Pop()
+		if !ok {
+			break
+		}
+		result = append(result, v)
+	}
+	return result
+}
+
+// InsertIfAbsent inserts item and reports true if it was not already
+// present, or reports false without modifying the set if it was. This is
+// the atomic compound operation callers would otherwise need their own lock
+// around a Contains+Insert pair to get right.
+func (s *SyncSet[T]) InsertIfAbsent(item T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.s.Contains(item) {
+		return false
+	}
+	s.s.Insert(item)
+	return true
+}
+
+// addr returns a stable address for s, used to order lock acquisition
+// between two SyncSets and avoid ABBA deadlocks.
+func (s *SyncSet[T]) addr() uintptr {
+	return uintptr(unsafe.Pointer(s))
+}
+
+// lockPairRLock read-locks a and b (which may be the same set) in a
+// deterministic order based on their addresses, and returns the unlock
+// function.
+func lockPairRLock[T comparable](a, b *SyncSet[T]) func() {
+	if a == b {
+		a.mu.RLock()
+		return a.mu.RUnlock
+	}
+	first, second := a, b
+	if first.addr() > second.addr() {
+		first, second = second, first
+	}
+	first.mu.RLock()
+	second.mu.RLock()
+	return func() {
+		second.mu.RUnlock()
+		first.mu.RUnlock()
+	}
+}
+
+// lockWriteThenRead write-locks a and read-locks b (which may be the same
+// set) in a deterministic order based on their addresses, avoiding ABBA
+// deadlocks with a concurrent call that locks the same pair in the opposite
+// role.
+func lockWriteThenRead[T comparable](a, b *SyncSet[T]) func() {
+	if a == b {
+		a.mu.Lock()
+		return a.mu.Unlock
+	}
+	if a.addr() < b.addr() {
+		a.mu.Lock()
+		b.mu.RLock()
+		return func() {
+			b.mu.RUnlock()
+			a.mu.Unlock()
+		}
+	}
+	b.mu.RLock()
+	a.mu.Lock()
+	return func() {
+		a.mu.Unlock()
+		b.mu.RUnlock()
+	}
+}
+
+// Merge adds every item of s2 into s and returns s.
+func (s *SyncSet[T]) Merge(s2 *SyncSet[T]) *SyncSet[T] {
+	unlock := lockWriteThenRead(s, s2)
+	defer unlock()
+	s.s.Merge(s2.s)
+	return s
+}
+
+// Union returns a new set which includes items in either s or s2.
+func (s *SyncSet[T]) Union(s2 *SyncSet[T]) *SyncSet[T] {
+	unlock := lockPairRLock(s, s2)
+	defer unlock()
+	return &SyncSet[T]{s: s.s.Union(s2.s)}
+}
+
+// Intersection returns a new set which includes the items in BOTH s and s2.
+func (s *SyncSet[T]) Intersection(s2 *SyncSet[T]) *SyncSet[T] {
+	unlock := lockPairRLock(s, s2)
+	defer unlock()
+	return &SyncSet[T]{s: s.s.Intersection(s2.s)}
+}
+
+// Difference returns a new set of objects from s that are not in s2.
+func (s *SyncSet[T]) Difference(s2 *SyncSet[T]) *SyncSet[T] {
+	unlock := lockPairRLock(s, s2)
+	defer unlock()
+	return &SyncSet[T]{s: s.s.Difference(s2.s)}
+}
+
+// Equal returns true if and only if s is equal (as a set) to s2.
+func (s *SyncSet[T]) Equal(s2 *SyncSet[T]) bool {
+	unlock := lockPairRLock(s, s2)
+	defer unlock()
+	return s.s.Equal(s2.s)
+}
+
+// SyncString is a thread-safe set of strings.
+type SyncString = SyncSet[string]
+
+// NewSyncString creates a SyncString from a list of values.
+func NewSyncString(items ...string) *SyncString { return NewSync(items...) }
+
+// SyncInt16 is a thread-safe set of int16s.
+type SyncInt16 = SyncSet[int16]
+
+// NewSyncInt16 creates a SyncInt16 from a list of values.
+func NewSyncInt16(items ...int16) *SyncInt16 { return NewSync(items...) }
+
+// SyncUint8 is a thread-safe set of uint8s.
+type SyncUint8 = SyncSet[uint8]
+
+// NewSyncUint8 creates a SyncUint8 from a list of values.
+func NewSyncUint8(items ...uint8) *SyncUint8 { return NewSync(items...) }
@@ -0,0 +1,68 @@
+// Package sync provides SyncSet, a concurrent-safe set with sharded
+// locking, for callers (peer trackers, in-memory indexes) embedding
+// sets.Set[T] in a server and mutating it from multiple goroutines.
+// sets.SyncSet in the parent package guards a single Set[T] with one
+// sync.RWMutex; SyncSet here instead hashes keys across N independent
+// shards, each with its own lock, so Insert/Delete/Contains calls on
+// unrelated keys don't serialize behind a single mutex under write
+// contention.
+package sync
+
+import "github.com/things-go/sets"
+
+// defaultShardCount is the number of shards a SyncSet uses when none is
+// given explicitly.
+const defaultShardCount = 32
+
+// SyncSet is a concurrent set that hashes keys across n independent
+// sets.Set[T] shards, each guarded by its own sync.RWMutex. It wraps
+// sets.ShardedSet[T], which implements the sharding; SyncSet exists so
+// callers of this package get a package-local name and the fluent
+// *SyncSet[T] return types its API had before the sharding logic moved.
+type SyncSet[T comparable] struct {
+	*sets.ShardedSet[T]
+}
+
+// New creates a SyncSet with n shards (defaultShardCount if n <= 0) from a
+// list of values.
+func New[T comparable](n int, items ...T) *SyncSet[T] {
+	if n <= 0 {
+		n = defaultShardCount
+	}
+	return &SyncSet[T]{sets.NewSharded(n, items...)}
+}
+
+// Insert adds items to the set.
+func (s *SyncSet[T]) Insert(items ...T) *SyncSet[T] {
+	s.ShardedSet.Insert(items...)
+	return s
+}
+
+// Delete removes all items from the set.
+func (s *SyncSet[T]) Delete(items ...T) *SyncSet[T] {
+	s.ShardedSet.Delete(items...)
+	return s
+}
+
+// Clone returns a new SyncSet, with the same shard count, containing a
+// copy of s.
+func (s *SyncSet[T]) Clone() *SyncSet[T] {
+	return &SyncSet[T]{s.ShardedSet.Clone()}
+}
+
+// Union returns a new SyncSet which includes items in either s or s2.
+func (s *SyncSet[T]) Union(s2 *SyncSet[T]) *SyncSet[T] {
+	return &SyncSet[T]{s.ShardedSet.Union(s2.ShardedSet)}
+}
+
+// Intersection returns a new SyncSet which includes the items in BOTH s
+// and s2.
+func (s *SyncSet[T]) Intersection(s2 *SyncSet[T]) *SyncSet[T] {
+	return &SyncSet[T]{s.ShardedSet.Intersection(s2.ShardedSet)}
+}
+
+// Difference returns a new SyncSet of items in s that are not in s2.
+func (s *SyncSet[T]) Difference(s2 *SyncSet[T]) *SyncSet[T] {
+	_, removed, _ := s.ShardedSet.Diff(s2.ShardedSet)
+	return &SyncSet[T]{removed}
+}
@@ -0,0 +1,74 @@
+package sync
+
+import (
+	stdsync "sync"
+	"testing"
+
+	"github.com/things-go/sets"
+)
+
+func TestSyncSet_ConcurrentInsert(t *testing.T) {
+	s := New[int](8)
+	var wg stdsync.WaitGroup
+	for i := 0; i < 200; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			s.Insert(i)
+		}(i)
+	}
+	wg.Wait()
+	if s.Len() != 200 {
+		t.Errorf("Expected len=200: %d", s.Len())
+	}
+}
+
+func TestSyncSet_DefaultShardCount(t *testing.T) {
+	s := New[string](0, "a", "b")
+	if s.ShardCount() != defaultShardCount {
+		t.Errorf("Expected %d shards, got %d", defaultShardCount, s.ShardCount())
+	}
+}
+
+func TestSyncSet_Ops(t *testing.T) {
+	a := New[int](4, 1, 2, 3)
+	b := New[int](4, 2, 3, 4)
+
+	union := a.Union(b)
+	if !union.Snapshot().Equal(sets.New(1, 2, 3, 4)) {
+		t.Errorf("Unexpected union: %v", union.List())
+	}
+
+	inter := a.Intersection(b)
+	if !inter.Snapshot().Equal(sets.New(2, 3)) {
+		t.Errorf("Unexpected intersection: %v", inter.List())
+	}
+
+	diff := a.Difference(b)
+	if !diff.Snapshot().Equal(sets.New(1)) {
+		t.Errorf("Unexpected difference: %v", diff.List())
+	}
+}
+
+func TestSyncSet_DeleteAndContains(t *testing.T) {
+	s := New[int](4, 1, 2, 3)
+	s.Delete(2)
+	if s.Contains(2) {
+		t.Errorf("Unexpected contents: %v", s.List())
+	}
+	if !s.Contains(1) || !s.Contains(3) {
+		t.Errorf("Missing contents: %v", s.List())
+	}
+}
+
+func TestSyncSet_Snapshot(t *testing.T) {
+	s := New[int](4, 1, 2, 3)
+	snap := s.Snapshot()
+	s.Insert(4)
+	if snap.Contains(4) {
+		t.Errorf("Snapshot should not observe later inserts: %v", snap.List())
+	}
+	if !snap.Equal(sets.New(1, 2, 3)) {
+		t.Errorf("Unexpected snapshot: %v", snap.List())
+	}
+}
@@ -0,0 +1,99 @@
+package sets
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestSyncSet_ConcurrentInsert(t *testing.T) {
+	s := NewSync[int]()
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			s.Insert(i)
+		}(i)
+	}
+	wg.Wait()
+	if s.Len() != 100 {
+		t.Errorf("Expected len=100: %d", s.Len())
+	}
+}
+
+func TestSyncSet_Ops(t *testing.T) {
+	a := NewSync(1, 2, 3)
+	b := NewSync(2, 3, 4)
+
+	if !a.Union(b).Equal(NewSync(1, 2, 3, 4)) {
+		t.Errorf("Unexpected union")
+	}
+	if !a.Intersection(b).Equal(NewSync(2, 3)) {
+		t.Errorf("Unexpected intersection")
+	}
+	if !a.Difference(b).Equal(NewSync(1)) {
+		t.Errorf("Unexpected difference")
+	}
+}
+
+func TestSyncSet_Merge(t *testing.T) {
+	a := NewSync(1, 2)
+	b := NewSync(2, 3)
+
+	if a.Merge(b) != a {
+		t.Errorf("Expected Merge to return the receiver")
+	}
+	if !a.Equal(NewSync(1, 2, 3)) {
+		t.Errorf("Unexpected merge result: %v", a.List())
+	}
+	if !b.Equal(NewSync(2, 3)) {
+		t.Errorf("Merge should not modify s2: %v", b.List())
+	}
+
+	a.Merge(a)
+	if !a.Equal(NewSync(1, 2, 3)) {
+		t.Errorf("Merge with self should be a no-op: %v", a.List())
+	}
+}
+
+func TestSyncSet_SelfOps(t *testing.T) {
+	a := NewSync(1, 2, 3)
+	if !a.Union(a).Equal(a) {
+		t.Errorf("Union with self should equal self")
+	}
+}
+
+func TestSyncSet_InsertIfAbsent(t *testing.T) {
+	s := NewSync(1)
+	if s.InsertIfAbsent(1) {
+		t.Errorf("Expected InsertIfAbsent(1) to report false, already present")
+	}
+	if !s.InsertIfAbsent(2) {
+		t.Errorf("Expected InsertIfAbsent(2) to report true, newly inserted")
+	}
+	if s.Len() != 2 {
+		t.Errorf("Expected len=2: %d", s.Len())
+	}
+}
+
+func TestSyncSet_PopN(t *testing.T) {
+	s := NewSync(1, 2, 3)
+	got := s.PopN(2)
+	if len(got) != 2 {
+		t.Errorf("Expected 2 popped elements, got %d", len(got))
+	}
+	if s.Len() != 1 {
+		t.Errorf("Expected len=1: %d", s.Len())
+	}
+	got = s.PopN(5)
+	if len(got) != 1 {
+		t.Errorf("Expected PopN to cap at remaining elements, got %d", len(got))
+	}
+}
+
+func TestSyncSet_AsInterface(t *testing.T) {
+	var iface Interface[int] = NewSync(1, 2, 3)
+	if !iface.Contains(1) || iface.Len() != 3 {
+		t.Errorf("Unexpected interface behavior")
+	}
+}
@@ -0,0 +1,370 @@
+package sets
+
+// treeNode is a node of the AVL tree backing TreeSet.
+type treeNode[T any] struct {
+	value       T
+	left, right *treeNode[T]
+	height      int
+}
+
+func nodeHeight[T any](n *treeNode[T]) int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+func nodeBalance[T any](n *treeNode[T]) int {
+	if n == nil {
+		return 0
+	}
+	return nodeHeight(n.left) - nodeHeight(n.right)
+}
+
+func updateHeight[T any](n *treeNode[T]) {
+	l, r := nodeHeight(n.left), nodeHeight(n.right)
+	if l > r {
+		n.height = l + 1
+	} else {
+		n.height = r + 1
+	}
+}
+
+func rotateRight[T any](y *treeNode[T]) *treeNode[T] {
+	x := y.left
+	t2 := x.right
+	x.right = y
+	y.left = t2
+	updateHeight(y)
+	updateHeight(x)
+	return x
+}
+
+func rotateLeft[T any](x *treeNode[T]) *treeNode[T] {
+	y := x.right
+	t2 := y.left
+	y.left = x
+	x.right = t2
+	updateHeight(x)
+	updateHeight(y)
+	return y
+}
+
+func rebalance[T any](n *treeNode[T]) *treeNode[T] {
+	updateHeight(n)
+	balance := nodeBalance(n)
+	if balance > 1 {
+		if nodeBalance(n.left) < 0 {
+			n.left = rotateLeft(n.left)
+		}
+		return rotateRight(n)
+	}
+	if balance < -1 {
+		if nodeBalance(n.right) > 0 {
+			n.right = rotateRight(n.right)
+		}
+		return rotateLeft(n)
+	}
+	return n
+}
+
+// TreeSet is a set of elements ordered by a user-supplied Comparator,
+// stored in a self-balancing AVL tree. Unlike the hash-map-based sets in
+// this package, TreeSet can hold non-comparable element types (e.g. structs
+// ordered by a business key) and offers ordered-only operations that a hash
+// set cannot support efficiently.
+type TreeSet[T any] struct {
+	root *treeNode[T]
+	cmp  Comparator
+	size int
+}
+
+// NewTreeSet creates a TreeSet ordered by cmp from a list of values.
+func NewTreeSet[T any](cmp Comparator, items ...T) *TreeSet[T] {
+	s := &TreeSet[T]{cmp: cmp}
+	s.Insert(items...)
+	return s
+}
+
+// Insert adds items to the set.
+func (s *TreeSet[T]) Insert(items ...T) *TreeSet[T] {
+	for _, item := range items {
+		s.root = s.insert(s.root, item)
+	}
+	return s
+}
+
+func (s *TreeSet[T]) insert(n *treeNode[T], value T) *treeNode[T] {
+	if n == nil {
+		s.size++
+		return &treeNode[T]{value: value, height: 1}
+	}
+	switch c := s.cmp.Compare(value, n.value); {
+	case c < 0:
+		n.left = s.insert(n.left, value)
+	case c > 0:
+		n.right = s.insert(n.right, value)
+	default:
+		n.value = value
+		return n
+	}
+	return rebalance(n)
+}
+
+// Delete removes all items from the set.
+func (s *TreeSet[T]) Delete(items ...T) *TreeSet[T] {
+	for _, item := range items {
+		s.root = s.delete(s.root, item)
+	}
+	return s
+}
+
+func (s *TreeSet[T]) delete(n *treeNode[T], value T) *treeNode[T] {
+	if n == nil {
+		return nil
+	}
+	switch c := s.cmp.Compare(value, n.value); {
+	case c < 0:
+		n.left = s.delete(n.left, value)
+	case c > 0:
+		n.right = s.delete(n.right, value)
+	default:
+		if n.left == nil {
+			s.size--
+			return n.right
+		}
+		if n.right == nil {
+			s.size--
+			return n.left
+		}
+		succ := n.right
+		for succ.left != nil {
+			succ = succ.left
+		}
+		n.value = succ.value
+		// The successor always has no left child, so this recursive call
+		// takes the n.left == nil branch above and performs the size--.
+		n.right = s.delete(n.right, succ.value)
+		return rebalance(n)
+	}
+	return rebalance(n)
+}
+
+// Contains returns true if and only if item is contained in the set.
+func (s *TreeSet[T]) Contains(item T) bool {
+	n := s.root
+	for n != nil {
+		switch c := s.cmp.Compare(item, n.value); {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			return true
+		}
+	}
+	return false
+}
+
+// Len returns the size of the set.
+func (s *TreeSet[T]) Len() int {
+	return s.size
+}
+
+// Min returns the smallest element of the set.
+func (s *TreeSet[T]) Min() (v T, ok bool) {
+	if s.root == nil {
+		return v, false
+	}
+	n := s.root
+	for n.left != nil {
+		n = n.left
+	}
+	return n.value, true
+}
+
+// Max returns the largest element of the set.
+func (s *TreeSet[T]) Max() (v T, ok bool) {
+	if s.root == nil {
+		return v, false
+	}
+	n := s.root
+	for n.right != nil {
+		n = n.right
+	}
+	return n.value, true
+}
+
+// Floor returns the largest element <= value, if any.
+func (s *TreeSet[T]) Floor(value T) (v T, ok bool) {
+	n := s.root
+	for n != nil {
+		c := s.cmp.Compare(n.value, value)
+		if c == 0 {
+			return n.value, true
+		}
+		if c < 0 {
+			v, ok = n.value, true
+			n = n.right
+		} else {
+			n = n.left
+		}
+	}
+	return v, ok
+}
+
+// Ceiling returns the smallest element >= value, if any.
+func (s *TreeSet[T]) Ceiling(value T) (v T, ok bool) {
+	n := s.root
+	for n != nil {
+		c := s.cmp.Compare(n.value, value)
+		if c == 0 {
+			return n.value, true
+		}
+		if c > 0 {
+			v, ok = n.value, true
+			n = n.left
+		} else {
+			n = n.right
+		}
+	}
+	return v, ok
+}
+
+// Each visits every element in ascending order, calling f for each. The
+// traversal stops early if f returns false.
+func (s *TreeSet[T]) Each(f func(item T) bool) {
+	var walk func(n *treeNode[T]) bool
+	walk = func(n *treeNode[T]) bool {
+		if n == nil {
+			return true
+		}
+		if !walk(n.left) {
+			return false
+		}
+		if !f(n.value) {
+			return false
+		}
+		return walk(n.right)
+	}
+	walk(s.root)
+}
+
+// RangeInclusive visits every element v with lo <= v <= hi in ascending
+// order, calling f for each. The traversal stops early if f returns false.
+func (s *TreeSet[T]) RangeInclusive(lo, hi T, f func(item T) bool) {
+	var walk func(n *treeNode[T]) bool
+	walk = func(n *treeNode[T]) bool {
+		if n == nil {
+			return true
+		}
+		if s.cmp.Compare(n.value, lo) > 0 {
+			if !walk(n.left) {
+				return false
+			}
+		}
+		if s.cmp.Compare(n.value, lo) >= 0 && s.cmp.Compare(n.value, hi) <= 0 {
+			if !f(n.value) {
+				return false
+			}
+		}
+		if s.cmp.Compare(n.value, hi) < 0 {
+			return walk(n.right)
+		}
+		return true
+	}
+	walk(s.root)
+}
+
+// Iterator returns a TreeIterator over the set in ascending order.
+func (s *TreeSet[T]) Iterator() *TreeIterator[T] {
+	it := &TreeIterator[T]{}
+	it.pushLeft(s.root)
+	return it
+}
+
+// TreeIterator walks a TreeSet in ascending order.
+type TreeIterator[T any] struct {
+	stack []*treeNode[T]
+}
+
+func (it *TreeIterator[T]) pushLeft(n *treeNode[T]) {
+	for n != nil {
+		it.stack = append(it.stack, n)
+		n = n.left
+	}
+}
+
+// Next returns the next element in ascending order, and whether one exists.
+func (it *TreeIterator[T]) Next() (v T, ok bool) {
+	if len(it.stack) == 0 {
+		return v, false
+	}
+	n := it.stack[len(it.stack)-1]
+	it.stack = it.stack[:len(it.stack)-1]
+	it.pushLeft(n.right)
+	return n.value, true
+}
+
+// List returns the elements already in comparator order, without a sort
+// pass.
+func (s *TreeSet[T]) List() []T {
+	res := make([]T, 0, s.size)
+	s.Each(func(item T) bool {
+		res = append(res, item)
+		return true
+	})
+	return res
+}
+
+// Clone returns a new TreeSet with a copy of s.
+func (s *TreeSet[T]) Clone() *TreeSet[T] {
+	return NewTreeSet(s.cmp, s.List()...)
+}
+
+// Union returns a new set which includes items in either s or s2.
+func (s *TreeSet[T]) Union(s2 *TreeSet[T]) *TreeSet[T] {
+	result := s.Clone()
+	result.Insert(s2.List()...)
+	return result
+}
+
+// Intersection returns a new set which includes the items in BOTH s and s2.
+func (s *TreeSet[T]) Intersection(s2 *TreeSet[T]) *TreeSet[T] {
+	result := NewTreeSet[T](s.cmp)
+	s.Each(func(item T) bool {
+		if s2.Contains(item) {
+			result.Insert(item)
+		}
+		return true
+	})
+	return result
+}
+
+// Difference returns a set of objects from s that are not in s2.
+func (s *TreeSet[T]) Difference(s2 *TreeSet[T]) *TreeSet[T] {
+	result := NewTreeSet[T](s.cmp)
+	s.Each(func(item T) bool {
+		if !s2.Contains(item) {
+			result.Insert(item)
+		}
+		return true
+	})
+	return result
+}
+
+// Equal returns true if and only if s is equal (as a set) to s2.
+func (s *TreeSet[T]) Equal(s2 *TreeSet[T]) bool {
+	if s.Len() != s2.Len() {
+		return false
+	}
+	equal := true
+	s.Each(func(item T) bool {
+		if !s2.Contains(item) {
+			equal = false
+			return false
+		}
+		return true
+	})
+	return equal
+}
@@ -0,0 +1,123 @@
+package sets
+
+import "testing"
+
+type intComparator struct{}
+
+func (intComparator) Compare(v1, v2 interface{}) int {
+	a, b := v1.(int), v2.(int)
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func TestTreeSet_InsertContainsDelete(t *testing.T) {
+	s := NewTreeSet[int](intComparator{}, 5, 3, 8, 1, 4, 7, 9)
+	if s.Len() != 7 {
+		t.Errorf("Expected len=7: %d", s.Len())
+	}
+	if !s.Contains(4) {
+		t.Errorf("Missing contents: %#v", s.List())
+	}
+	s.Delete(4, 8)
+	if s.Len() != 5 {
+		t.Errorf("Expected len=5: %d", s.Len())
+	}
+	if s.Contains(4) || s.Contains(8) {
+		t.Errorf("Unexpected contents: %#v", s.List())
+	}
+}
+
+func TestTreeSet_OrderedList(t *testing.T) {
+	s := NewTreeSet[int](intComparator{}, 5, 3, 8, 1, 4)
+	want := []int{1, 3, 4, 5, 8}
+	got := s.List()
+	if len(got) != len(want) {
+		t.Fatalf("Expected %v, got %v", want, got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Expected %v, got %v", want, got)
+		}
+	}
+}
+
+func TestTreeSet_MinMaxFloorCeiling(t *testing.T) {
+	s := NewTreeSet[int](intComparator{}, 10, 20, 30, 40)
+
+	if min, ok := s.Min(); !ok || min != 10 {
+		t.Errorf("Expected min=10, got %v, %v", min, ok)
+	}
+	if max, ok := s.Max(); !ok || max != 40 {
+		t.Errorf("Expected max=40, got %v, %v", max, ok)
+	}
+	if f, ok := s.Floor(25); !ok || f != 20 {
+		t.Errorf("Expected floor(25)=20, got %v, %v", f, ok)
+	}
+	if c, ok := s.Ceiling(25); !ok || c != 30 {
+		t.Errorf("Expected ceiling(25)=30, got %v, %v", c, ok)
+	}
+	if _, ok := s.Floor(5); ok {
+		t.Errorf("Expected no floor below min")
+	}
+}
+
+func TestTreeSet_RangeInclusive(t *testing.T) {
+	s := NewTreeSet[int](intComparator{}, 1, 2, 3, 4, 5, 6)
+	var got []int
+	s.RangeInclusive(2, 5, func(item int) bool {
+		got = append(got, item)
+		return true
+	})
+	want := []int{2, 3, 4, 5}
+	if len(got) != len(want) {
+		t.Fatalf("Expected %v, got %v", want, got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Expected %v, got %v", want, got)
+		}
+	}
+}
+
+func TestTreeSet_Iterator(t *testing.T) {
+	s := NewTreeSet[int](intComparator{}, 3, 1, 2)
+	it := s.Iterator()
+	var got []int
+	for {
+		v, ok := it.Next()
+		if !ok {
+			break
+		}
+		got = append(got, v)
+	}
+	want := []int{1, 2, 3}
+	if len(got) != len(want) {
+		t.Fatalf("Expected %v, got %v", want, got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Expected %v, got %v", want, got)
+		}
+	}
+}
+
+func TestTreeSet_SetOps(t *testing.T) {
+	a := NewTreeSet[int](intComparator{}, 1, 2, 3)
+	b := NewTreeSet[int](intComparator{}, 2, 3, 4)
+
+	if !a.Union(b).Equal(NewTreeSet[int](intComparator{}, 1, 2, 3, 4)) {
+		t.Errorf("Unexpected union")
+	}
+	if !a.Intersection(b).Equal(NewTreeSet[int](intComparator{}, 2, 3)) {
+		t.Errorf("Unexpected intersection")
+	}
+	if !a.Difference(b).Equal(NewTreeSet[int](intComparator{}, 1)) {
+		t.Errorf("Unexpected difference")
+	}
+}
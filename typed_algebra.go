@@ -0,0 +1,389 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sets
+
+// SymmetricDifference returns a new set of elements that are in exactly one
+// of s1 or s2.
+func (s Byte) SymmetricDifference(s2 Byte) Byte {
+	return s.Difference(s2).Union(s2.Difference(s))
+}
+
+// Filter returns a new set containing only the elements for which f returns true.
+func (s Byte) Filter(f func(item byte) bool) Byte {
+	result := NewByte()
+	s.Each(func(item byte) bool {
+		if f(item) {
+			result.Insert(item)
+		}
+		return true
+	})
+	return result
+}
+
+// Any returns true if f returns true for at least one element of s.
+func (s Byte) Any(f func(item byte) bool) bool {
+	found := false
+	s.Each(func(item byte) bool {
+		if f(item) {
+			found = true
+			return false
+		}
+		return true
+	})
+	return found
+}
+
+// All returns true if f returns true for every element of s, or s is empty.
+func (s Byte) All(f func(item byte) bool) bool {
+	ok := true
+	s.Each(func(item byte) bool {
+		if !f(item) {
+			ok = false
+			return false
+		}
+		return true
+	})
+	return ok
+}
+
+// SymmetricDifference returns a new set of elements that are in exactly one
+// of s1 or s2.
+func (s Int) SymmetricDifference(s2 Int) Int {
+	return s.Difference(s2).Union(s2.Difference(s))
+}
+
+// Filter returns a new set containing only the elements for which f returns true.
+func (s Int) Filter(f func(item int) bool) Int {
+	result := NewInt()
+	s.Each(func(item int) bool {
+		if f(item) {
+			result.Insert(item)
+		}
+		return true
+	})
+	return result
+}
+
+// Any returns true if f returns true for at least one element of s.
+func (s Int) Any(f func(item int) bool) bool {
+	found := false
+	s.Each(func(item int) bool {
+		if f(item) {
+			found = true
+			return false
+		}
+		return true
+	})
+	return found
+}
+
+// All returns true if f returns true for every element of s, or s is empty.
+func (s Int) All(f func(item int) bool) bool {
+	ok := true
+	s.Each(func(item int) bool {
+		if !f(item) {
+			ok = false
+			return false
+		}
+		return true
+	})
+	return ok
+}
+
+// Filter returns a new set containing only the elements for which f returns true.
+func (s Int8) Filter(f func(item int8) bool) Int8 {
+	result := NewInt8()
+	s.Each(func(item int8) bool {
+		if f(item) {
+			result.Insert(item)
+		}
+		return true
+	})
+	return result
+}
+
+// Any returns true if f returns true for at least one element of s.
+func (s Int8) Any(f func(item int8) bool) bool {
+	found := false
+	s.Each(func(item int8) bool {
+		if f(item) {
+			found = true
+			return false
+		}
+		return true
+	})
+	return found
+}
+
+// All returns true if f returns true for every element of s, or s is empty.
+func (s Int8) All(f func(item int8) bool) bool {
+	ok := true
+	s.Each(func(item int8) bool {
+		if !f(item) {
+			ok = false
+			return false
+		}
+		return true
+	})
+	return ok
+}
+
+// Filter returns a new set containing only the elements for which f returns true.
+func (s Int16) Filter(f func(item int16) bool) Int16 {
+	result := NewInt16()
+	s.Each(func(item int16) bool {
+		if f(item) {
+			result.Insert(item)
+		}
+		return true
+	})
+	return result
+}
+
+// Any returns true if f returns true for at least one element of s.
+func (s Int16) Any(f func(item int16) bool) bool {
+	found := false
+	s.Each(func(item int16) bool {
+		if f(item) {
+			found = true
+			return false
+		}
+		return true
+	})
+	return found
+}
+
+// All returns true if f returns true for every element of s, or s is empty.
+func (s Int16) All(f func(item int16) bool) bool {
+	ok := true
+	s.Each(func(item int16) bool {
+		if !f(item) {
+			ok = false
+			return false
+		}
+		return true
+	})
+	return ok
+}
+
+// SymmetricDifference returns a new set of elements that are in exactly one
+// of s1 or s2.
+func (s Int32) SymmetricDifference(s2 Int32) Int32 {
+	return s.Difference(s2).Union(s2.Difference(s))
+}
+
+// Filter returns a new set containing only the elements for which f returns true.
+//
+// Int32's Each predates the generic func(item T) bool signature used by the
+// other typed sets (it takes func(item interface{}) bool), so this ranges
+// over the map directly rather than going through Each.
+func (s Int32) Filter(f func(item int32) bool) Int32 {
+	result := NewInt32()
+	for item := range s {
+		if f(item) {
+			result.Insert(item)
+		}
+	}
+	return result
+}
+
+// Any returns true if f returns true for at least one element of s.
+func (s Int32) Any(f func(item int32) bool) bool {
+	for item := range s {
+		if f(item) {
+			return true
+		}
+	}
+	return false
+}
+
+// All returns true if f returns true for every element of s, or s is empty.
+func (s Int32) All(f func(item int32) bool) bool {
+	for item := range s {
+		if !f(item) {
+			return false
+		}
+	}
+	return true
+}
+
+// SymmetricDifference returns a new set of elements that are in exactly one
+// of s1 or s2.
+func (s Int64) SymmetricDifference(s2 Int64) Int64 {
+	return s.Difference(s2).Union(s2.Difference(s))
+}
+
+// Filter returns a new set containing only the elements for which f returns true.
+func (s Int64) Filter(f func(item int64) bool) Int64 {
+	result := NewInt64()
+	s.Each(func(item int64) bool {
+		if f(item) {
+			result.Insert(item)
+		}
+		return true
+	})
+	return result
+}
+
+// Any returns true if f returns true for at least one element of s.
+func (s Int64) Any(f func(item int64) bool) bool {
+	found := false
+	s.Each(func(item int64) bool {
+		if f(item) {
+			found = true
+			return false
+		}
+		return true
+	})
+	return found
+}
+
+// All returns true if f returns true for every element of s, or s is empty.
+func (s Int64) All(f func(item int64) bool) bool {
+	ok := true
+	s.Each(func(item int64) bool {
+		if !f(item) {
+			ok = false
+			return false
+		}
+		return true
+	})
+	return ok
+}
+
+// Filter returns a new set containing only the elements for which f returns true.
+func (s Uint8) Filter(f func(item uint8) bool) Uint8 {
+	result := NewUint8()
+	s.Each(func(item uint8) bool {
+		if f(item) {
+			result.Insert(item)
+		}
+		return true
+	})
+	return result
+}
+
+// Any returns true if f returns true for at least one element of s.
+func (s Uint8) Any(f func(item uint8) bool) bool {
+	found := false
+	s.Each(func(item uint8) bool {
+		if f(item) {
+			found = true
+			return false
+		}
+		return true
+	})
+	return found
+}
+
+// All returns true if f returns true for every element of s, or s is empty.
+func (s Uint8) All(f func(item uint8) bool) bool {
+	ok := true
+	s.Each(func(item uint8) bool {
+		if !f(item) {
+			ok = false
+			return false
+		}
+		return true
+	})
+	return ok
+}
+
+// SymmetricDifference returns a new set of elements that are in exactly one
+// of s1 or s2.
+func (s Uint16) SymmetricDifference(s2 Uint16) Uint16 {
+	return s.Difference(s2).Union(s2.Difference(s))
+}
+
+// Filter returns a new set containing only the elements for which f returns true.
+func (s Uint16) Filter(f func(item uint16) bool) Uint16 {
+	result := NewUint16()
+	s.Each(func(item uint16) bool {
+		if f(item) {
+			result.Insert(item)
+		}
+		return true
+	})
+	return result
+}
+
+// Any returns true if f returns true for at least one element of s.
+func (s Uint16) Any(f func(item uint16) bool) bool {
+	found := false
+	s.Each(func(item uint16) bool {
+		if f(item) {
+			found = true
+			return false
+		}
+		return true
+	})
+	return found
+}
+
+// All returns true if f returns true for every element of s, or s is empty.
+func (s Uint16) All(f func(item uint16) bool) bool {
+	ok := true
+	s.Each(func(item uint16) bool {
+		if !f(item) {
+			ok = false
+			return false
+		}
+		return true
+	})
+	return ok
+}
+
+// SymmetricDifference returns a new set of elements that are in exactly one
+// of s1 or s2.
+func (s Uint32) SymmetricDifference(s2 Uint32) Uint32 {
+	return s.Difference(s2).Union(s2.Difference(s))
+}
+
+// Filter returns a new set containing only the elements for which f returns true.
+//
+// Uint32's Each predates the generic func(item T) bool signature used by the
+// other typed sets (it takes func(item interface{}) bool), so this ranges
+// over the map directly rather than going through Each.
+func (s Uint32) Filter(f func(item uint32) bool) Uint32 {
+	result := NewUint32()
+	for item := range s {
+		if f(item) {
+			result.Insert(item)
+		}
+	}
+	return result
+}
+
+// Any returns true if f returns true for at least one element of s.
+func (s Uint32) Any(f func(item uint32) bool) bool {
+	for item := range s {
+		if f(item) {
+			return true
+		}
+	}
+	return false
+}
+
+// All returns true if f returns true for every element of s, or s is empty.
+func (s Uint32) All(f func(item uint32) bool) bool {
+	for item := range s {
+		if !f(item) {
+			return false
+		}
+	}
+	return true
+}
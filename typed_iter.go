@@ -0,0 +1,212 @@
+//go:build go1.23
+
+package sets
+
+import "iter"
+
+// Values returns an iterator over s's elements in unspecified order.
+func (s Int) Values() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for k := range s {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// SortedValues returns an iterator over s's elements in ascending order.
+func (s Int) SortedValues() iter.Seq[int] {
+	items := s.List()
+	return func(yield func(int) bool) {
+		for _, v := range items {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over s's elements in unspecified order.
+func (s Int8) Values() iter.Seq[int8] {
+	return func(yield func(int8) bool) {
+		for k := range s {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// SortedValues returns an iterator over s's elements in ascending order.
+func (s Int8) SortedValues() iter.Seq[int8] {
+	items := s.List()
+	return func(yield func(int8) bool) {
+		for _, v := range items {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over s's elements in unspecified order.
+func (s Int16) Values() iter.Seq[int16] {
+	return func(yield func(int16) bool) {
+		for k := range s {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// SortedValues returns an iterator over s's elements in ascending order.
+func (s Int16) SortedValues() iter.Seq[int16] {
+	items := s.List()
+	return func(yield func(int16) bool) {
+		for _, v := range items {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over s's elements in unspecified order.
+func (s Int32) Values() iter.Seq[int32] {
+	return func(yield func(int32) bool) {
+		for k := range s {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// SortedValues returns an iterator over s's elements in ascending order.
+func (s Int32) SortedValues() iter.Seq[int32] {
+	items := s.List()
+	return func(yield func(int32) bool) {
+		for _, v := range items {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over s's elements in unspecified order.
+func (s Int64) Values() iter.Seq[int64] {
+	return func(yield func(int64) bool) {
+		for k := range s {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// SortedValues returns an iterator over s's elements in ascending order.
+func (s Int64) SortedValues() iter.Seq[int64] {
+	items := s.List()
+	return func(yield func(int64) bool) {
+		for _, v := range items {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over s's elements in unspecified order.
+func (s Uint8) Values() iter.Seq[uint8] {
+	return func(yield func(uint8) bool) {
+		for k := range s {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// SortedValues returns an iterator over s's elements in ascending order.
+func (s Uint8) SortedValues() iter.Seq[uint8] {
+	items := s.List()
+	return func(yield func(uint8) bool) {
+		for _, v := range items {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over s's elements in unspecified order.
+func (s Uint16) Values() iter.Seq[uint16] {
+	return func(yield func(uint16) bool) {
+		for k := range s {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// SortedValues returns an iterator over s's elements in ascending order.
+func (s Uint16) SortedValues() iter.Seq[uint16] {
+	items := s.List()
+	return func(yield func(uint16) bool) {
+		for _, v := range items {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over s's elements in unspecified order.
+func (s Uint32) Values() iter.Seq[uint32] {
+	return func(yield func(uint32) bool) {
+		for k := range s {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// SortedValues returns an iterator over s's elements in ascending order.
+func (s Uint32) SortedValues() iter.Seq[uint32] {
+	items := s.List()
+	return func(yield func(uint32) bool) {
+		for _, v := range items {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over s's elements in unspecified order.
+func (s Byte) Values() iter.Seq[byte] {
+	return func(yield func(byte) bool) {
+		for k := range s {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// SortedValues returns an iterator over s's elements in ascending order.
+func (s Byte) SortedValues() iter.Seq[byte] {
+	items := s.List()
+	return func(yield func(byte) bool) {
+		for _, v := range items {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
@@ -17,6 +17,9 @@ limitations under the License.
 package sets
 
 import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
 	"reflect"
 	"sort"
 )
@@ -243,3 +246,67 @@ func (s Uint16) Clone() Uint16 {
 	})
 	return ns
 }
+
+// MarshalJSON implements json.Marshaler, encoding the set as a sorted JSON
+// array for deterministic output.
+func (s Uint16) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.List())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (s *Uint16) UnmarshalJSON(data []byte) error {
+	var items []uint16
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+	if err := checkDuplicates(items); err != nil {
+		return err
+	}
+	*s = NewUint16(items...)
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder.
+func (s Uint16) GobEncode() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(s.List()); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// GobDecode implements gob.GobDecoder.
+func (s *Uint16) GobDecode(data []byte) error {
+	var items []uint16
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&items); err != nil {
+		return err
+	}
+	*s = NewUint16(items...)
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, using a compact
+// length-prefixed varint stream instead of gob's heavier type-descriptor
+// framing.
+func (s Uint16) MarshalBinary() ([]byte, error) {
+	items := s.List()
+	words := make([]uint64, len(items))
+	for i, item := range items {
+		words[i] = uint64(item)
+	}
+	return encodeUvarints(words), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *Uint16) UnmarshalBinary(data []byte) error {
+	words, err := decodeUvarints(data)
+	if err != nil {
+		return err
+	}
+	ret := NewUint16()
+	for _, w := range words {
+		ret.Insert(uint16(w))
+	}
+	*s = ret
+	return nil
+}
@@ -17,6 +17,9 @@ limitations under the License.
 package sets
 
 import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
 	"reflect"
 	"sort"
 )
@@ -243,3 +246,67 @@ func (s Uint32) Clone() Uint32 {
 	})
 	return ns
 }
+
+// MarshalJSON implements json.Marshaler, encoding the set as a sorted JSON
+// array for deterministic output.
+func (s Uint32) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.List())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (s *Uint32) UnmarshalJSON(data []byte) error {
+	var items []uint32
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+	if err := checkDuplicates(items); err != nil {
+		return err
+	}
+	*s = NewUint32(items...)
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder.
+func (s Uint32) GobEncode() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(s.List()); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// GobDecode implements gob.GobDecoder.
+func (s *Uint32) GobDecode(data []byte) error {
+	var items []uint32
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&items); err != nil {
+		return err
+	}
+	*s = NewUint32(items...)
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, using a compact
+// length-prefixed varint stream instead of gob's heavier type-descriptor
+// framing.
+func (s Uint32) MarshalBinary() ([]byte, error) {
+	items := s.List()
+	words := make([]uint64, len(items))
+	for i, item := range items {
+		words[i] = uint64(item)
+	}
+	return encodeUvarints(words), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *Uint32) UnmarshalBinary(data []byte) error {
+	words, err := decodeUvarints(data)
+	if err != nil {
+		return err
+	}
+	ret := NewUint32()
+	for _, w := range words {
+		ret.Insert(uint32(w))
+	}
+	*s = ret
+	return nil
+}
@@ -17,8 +17,13 @@ limitations under the License.
 package sets
 
 import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
 	"reflect"
 	"sort"
+	"strconv"
+	"strings"
 )
 
 // Uint8 is a set of uint8s, implemented via map[uint8]struct{} for minimal memory consumption.
@@ -243,3 +248,105 @@ func (s Uint8) Clone() Uint8 {
 	})
 	return ns
 }
+
+// SymmetricDifference returns a set of objects that are in s or s2, but not in both.
+// For example:
+// s1 = {a1, a2, a3}
+// s2 = {a1, a2, a4, a5}
+// s1.SymmetricDifference(s2) = {a3, a4, a5}
+func (s Uint8) SymmetricDifference(s2 Uint8) Uint8 {
+	result := NewUint8()
+	for key := range s {
+		if !s2.Contains(key) {
+			result[key] = struct{}{}
+		}
+	}
+	for key := range s2 {
+		if !s.Contains(key) {
+			result[key] = struct{}{}
+		}
+	}
+	return result
+}
+
+// MarshalJSON implements json.Marshaler, encoding the set as a sorted JSON
+// array for deterministic output.
+func (s Uint8) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.List())
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It accepts either a JSON array
+// of uint8s, or an object whose keys parse as uint8 (values are ignored).
+func (s *Uint8) UnmarshalJSON(data []byte) error {
+	var items []uint8
+	if err := json.Unmarshal(data, &items); err == nil {
+		if err := checkDuplicates(items); err != nil {
+			return err
+		}
+		*s = NewUint8(items...)
+		return nil
+	}
+	m := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &m); err != nil {
+		return err
+	}
+	ret := NewUint8()
+	for k := range m {
+		v, err := strconv.ParseUint(k, 10, 8)
+		if err != nil {
+			return err
+		}
+		ret.Insert(uint8(v))
+	}
+	*s = ret
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder.
+func (s Uint8) GobEncode() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(s.List()); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// GobDecode implements gob.GobDecoder.
+func (s *Uint8) GobDecode(data []byte) error {
+	var items []uint8
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&items); err != nil {
+		return err
+	}
+	*s = NewUint8(items...)
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding the set as a
+// sorted, comma-separated list for use in URL query params and env vars.
+func (s Uint8) MarshalText() ([]byte, error) {
+	items := s.List()
+	parts := make([]string, len(items))
+	for i, item := range items {
+		parts[i] = strconv.FormatUint(uint64(item), 10)
+	}
+	return []byte(strings.Join(parts, ",")), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *Uint8) UnmarshalText(text []byte) error {
+	ret := NewUint8()
+	str := strings.TrimSpace(string(text))
+	if str == "" {
+		*s = ret
+		return nil
+	}
+	for _, part := range strings.Split(str, ",") {
+		v, err := strconv.ParseUint(strings.TrimSpace(part), 10, 8)
+		if err != nil {
+			return err
+		}
+		ret.Insert(uint8(v))
+	}
+	*s = ret
+	return nil
+}